@@ -0,0 +1,194 @@
+// Package ansi interprets the ANSI escape sequences that tools colour their
+// logs with, converting them to HTML or stripping them.
+package ansi
+
+import (
+	"bytes"
+	"html"
+	"strings"
+)
+
+const (
+	// escape introduces every escape sequence.
+	escape = 0x1b
+
+	// bell terminates an operating system command.
+	bell = 0x07
+)
+
+// sequence is an escape sequence, or a run of text between escape sequences.
+type sequence struct {
+	// text is the run of text, if the sequence is not an escape sequence.
+	text string
+
+	// sgr holds the parameters of a Select Graphic Rendition sequence, e.g.
+	// "1;31" for "\x1b[1;31m".
+	sgr    string
+	isSGR  bool
+	escape bool
+}
+
+// scan splits the string into runs of text and the escape sequences between
+// them. Unterminated escape sequences at the end of the string are dropped.
+func scan(s string, yield func(sequence)) {
+	for len(s) > 0 {
+		start := strings.IndexByte(s, escape)
+		if start < 0 {
+			yield(sequence{text: s})
+
+			return
+		}
+
+		if start > 0 {
+			yield(sequence{text: s[:start]})
+		}
+
+		length := sequenceLength(s[start:])
+
+		if seq := s[start : start+length]; len(seq) > 2 && seq[1] == '[' && seq[len(seq)-1] == 'm' {
+			yield(sequence{sgr: seq[2 : len(seq)-1], isSGR: true, escape: true})
+		} else {
+			yield(sequence{escape: true})
+		}
+
+		s = s[start+length:]
+	}
+}
+
+// sequenceLength returns the length of the escape sequence at the start of the
+// string.
+func sequenceLength(s string) int {
+	if len(s) < 2 {
+		return len(s)
+	}
+
+	switch s[1] {
+	case '[':
+		// Control Sequence Introducer: parameter and intermediate bytes,
+		// followed by a final byte.
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7e {
+				return i + 1
+			}
+
+			if s[i] < 0x20 || s[i] > 0x3f {
+				// Not a valid control sequence, so only drop the
+				// introducer.
+				return i
+			}
+		}
+
+		return len(s)
+	case ']', 'P', '^', '_':
+		// Operating System Command and other strings, terminated by a bell
+		// or the String Terminator "\x1b\\".
+		for i := 2; i < len(s); i++ {
+			if s[i] == bell {
+				return i + 1
+			}
+
+			if s[i] == escape && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+
+		return len(s)
+	default:
+		return 2
+	}
+}
+
+// Strip removes all escape sequences from the bytes.
+func Strip(bs []byte) []byte {
+	if bytes.IndexByte(bs, escape) < 0 {
+		return bs
+	}
+
+	stripped := make([]byte, 0, len(bs))
+
+	scan(string(bs), func(seq sequence) {
+		stripped = append(stripped, seq.text...)
+	})
+
+	return stripped
+}
+
+// ToHTML converts text coloured with escape sequences into HTML, starting with
+// the given style, e.g. the style in effect at the end of the previous page of
+// a log. The text is escaped, and styled runs are wrapped in spans.
+//
+// The HTML is returned as lines, each closing any span that it opens so that
+// lines can be rendered separately, along with the style in effect at the end
+// of the text. Escape sequences other than those selecting the style are
+// dropped.
+func ToHTML(text string, style Style) ([]string, Style) {
+	var (
+		lines []string
+		line  strings.Builder
+
+		// open is the style of the open span, if any.
+		open    Style
+		spanned bool
+	)
+
+	closeSpan := func() {
+		if spanned {
+			line.WriteString("</span>")
+			spanned = false
+		}
+	}
+
+	write := func(text string) {
+		if text == "" {
+			return
+		}
+
+		if spanned && open != style {
+			closeSpan()
+		}
+
+		if !spanned && !style.IsZero() {
+			line.WriteString(`<span style="`)
+			line.WriteString(html.EscapeString(style.CSS()))
+			line.WriteString(`">`)
+
+			open = style
+			spanned = true
+		}
+
+		line.WriteString(html.EscapeString(text))
+	}
+
+	scan(text, func(seq sequence) {
+		if seq.isSGR {
+			style.Apply(seq.sgr)
+
+			return
+		}
+
+		if seq.escape {
+			return
+		}
+
+		for {
+			before, after, found := strings.Cut(seq.text, "\n")
+
+			write(before)
+
+			if !found {
+				break
+			}
+
+			closeSpan()
+
+			lines = append(lines, line.String())
+			line.Reset()
+
+			seq.text = after
+		}
+	})
+
+	closeSpan()
+
+	return append(lines, line.String()), style
+}
@@ -0,0 +1,115 @@
+package ansi
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStrip(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"plain", "plain text", "plain text"},
+		{"SGR", "\x1b[1;31merror\x1b[0m: failed", "error: failed"},
+		{"other control sequences", "\x1b[2Kcleared\x1b[1A", "cleared"},
+		{"operating system command", "\x1b]0;title\x07text\x1b]8;;url\x1b\\link", "textlink"},
+		{"invalid control sequence", "\x1b[\x01text", "\x01text"},
+		{"unterminated", "text\x1b[1;3", "text"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := string(Strip([]byte(test.text))); got != test.want {
+				t.Errorf("Strip(%q) = %q, want %q", test.text, got, test.want)
+			}
+		})
+	}
+}
+
+func TestToHTML(t *testing.T) {
+	bold := Style{Bold: true}
+
+	tests := []struct {
+		name      string
+		text      string
+		style     Style
+		want      []string
+		wantStyle Style
+	}{
+		{
+			name: "plain",
+			text: "a < b\nc",
+			want: []string{"a &lt; b", "c"},
+		},
+		{
+			name: "styled run",
+			text: "\x1b[31mred\x1b[0m plain",
+			want: []string{`<span style="color: #cd0000">red</span> plain`},
+		},
+		{
+			name: "span closed and reopened across lines",
+			text: "\x1b[31mred\nstill red\x1b[0m\nplain",
+			want: []string{
+				`<span style="color: #cd0000">red</span>`,
+				`<span style="color: #cd0000">still red</span>`,
+				"plain",
+			},
+		},
+		{
+			name: "style changed within a line",
+			text: "\x1b[31ma\x1b[32mb\x1b[1mc",
+			want: []string{
+				`<span style="color: #cd0000">a</span>` +
+					`<span style="color: #00cd00">b</span>` +
+					`<span style="color: #00cd00; font-weight: bold">c</span>`,
+			},
+			wantStyle: Style{Foreground: Colour{kind: indexedColour, index: 2}, Bold: true},
+		},
+		{
+			name:      "unchanged style continues the span",
+			text:      "\x1b[1ma\x1b[1mb",
+			want:      []string{`<span style="font-weight: bold">ab</span>`},
+			wantStyle: bold,
+		},
+		{
+			name:      "no spans for empty lines",
+			text:      "\x1b[1m\n\nbold\n",
+			want:      []string{"", "", `<span style="font-weight: bold">bold</span>`, ""},
+			wantStyle: bold,
+		},
+		{
+			name:      "starting style",
+			text:      "bold\x1b[22m plain",
+			style:     bold,
+			want:      []string{`<span style="font-weight: bold">bold</span> plain`},
+			wantStyle: Style{},
+		},
+		{
+			name:      "style carried to the end",
+			text:      "plain \x1b[1mbold",
+			want:      []string{`plain <span style="font-weight: bold">bold</span>`},
+			wantStyle: bold,
+		},
+		{
+			name: "other escape sequences dropped",
+			text: "a\x1b]0;title\x07b\x1b[2Kc",
+			want: []string{"abc"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lines, style := ToHTML(test.text, test.style)
+
+			if !slices.Equal(lines, test.want) {
+				t.Errorf("ToHTML(%q) = %q, want %q", test.text, lines, test.want)
+			}
+
+			if style != test.wantStyle {
+				t.Errorf("ToHTML(%q) ended with %+v, want %+v", test.text, style, test.wantStyle)
+			}
+		})
+	}
+}
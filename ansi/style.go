@@ -0,0 +1,351 @@
+package ansi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidStyle is returned when a style is parsed from something other than
+// the parameters of a Select Graphic Rendition sequence.
+var ErrInvalidStyle = errors.New("ansi: invalid style")
+
+// colourKind is the kind of a colour, which determines how its value is
+// interpreted.
+type colourKind uint8
+
+const (
+	// defaultColour is the colour of unstyled text.
+	defaultColour colourKind = iota
+
+	// indexedColour is one of the 256 colours of the terminal palette, the
+	// first 16 of which are the basic and bright colours.
+	indexedColour
+
+	// rgbColour is a 24-bit "truecolor" colour.
+	rgbColour
+)
+
+// Colour is a foreground or background colour.
+type Colour struct {
+	kind    colourKind
+	index   uint8
+	r, g, b uint8
+}
+
+// basicColours are the CSS colours of the 16 basic and bright colours of the
+// palette, as in xterm.
+var basicColours = [16]string{
+	"#000000", "#cd0000", "#00cd00", "#cdcd00",
+	"#0000ee", "#cd00cd", "#00cdcd", "#e5e5e5",
+	"#7f7f7f", "#ff0000", "#00ff00", "#ffff00",
+	"#5c5cff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// cubeLevels are the intensities of each channel of the 6x6x6 colour cube
+// making up colours 16 to 231 of the palette.
+var cubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// CSS returns the colour as a CSS colour, or the given default if unset.
+func (c Colour) CSS(def string) string {
+	switch c.kind {
+	case indexedColour:
+		switch {
+		case c.index < 16:
+			return basicColours[c.index]
+		case c.index < 232:
+			cube := c.index - 16
+
+			return fmt.Sprintf(
+				"#%02x%02x%02x",
+				cubeLevels[cube/36],
+				cubeLevels[cube/6%6],
+				cubeLevels[cube%6],
+			)
+		default:
+			grey := 8 + 10*(c.index-232)
+
+			return fmt.Sprintf("#%02x%02x%02x", grey, grey, grey)
+		}
+	case rgbColour:
+		return fmt.Sprintf("#%02x%02x%02x", c.r, c.g, c.b)
+	default:
+		return def
+	}
+}
+
+// sgr returns the parameters selecting the colour, given the base parameter
+// of the foreground (30) or background (40).
+func (c Colour) sgr(base int) []string {
+	switch c.kind {
+	case indexedColour:
+		switch {
+		case c.index < 8:
+			return []string{strconv.Itoa(base + int(c.index))}
+		case c.index < 16:
+			return []string{strconv.Itoa(base + 60 + int(c.index) - 8)}
+		default:
+			return []string{strconv.Itoa(base + 8), "5", strconv.Itoa(int(c.index))}
+		}
+	case rgbColour:
+		return []string{
+			strconv.Itoa(base + 8), "2",
+			strconv.Itoa(int(c.r)), strconv.Itoa(int(c.g)), strconv.Itoa(int(c.b)),
+		}
+	default:
+		return nil
+	}
+}
+
+// Style is the graphic rendition of text, as selected by SGR sequences.
+type Style struct {
+	Foreground Colour
+	Background Colour
+
+	Bold          bool
+	Faint         bool
+	Italic        bool
+	Underline     bool
+	Blink         bool
+	Inverse       bool
+	Hidden        bool
+	Strikethrough bool
+}
+
+// ParseStyle parses a style from the parameters of an SGR sequence, e.g.
+// "1;31", as returned by [Style.String].
+func ParseStyle(sgr string) (Style, error) {
+	var style Style
+
+	if strings.Trim(sgr, "0123456789;:") != "" {
+		return style, fmt.Errorf("%w: %q", ErrInvalidStyle, sgr)
+	}
+
+	style.Apply(sgr)
+
+	return style, nil
+}
+
+// IsZero reports whether the style is that of unstyled text.
+func (s Style) IsZero() bool {
+	return s == Style{}
+}
+
+// String returns the parameters of the SGR sequence selecting the style from
+// unstyled text, e.g. "1;31", or an empty string for unstyled text.
+func (s Style) String() string {
+	var params []string
+
+	for _, attribute := range []struct {
+		set   bool
+		param string
+	}{
+		{s.Bold, "1"},
+		{s.Faint, "2"},
+		{s.Italic, "3"},
+		{s.Underline, "4"},
+		{s.Blink, "5"},
+		{s.Inverse, "7"},
+		{s.Hidden, "8"},
+		{s.Strikethrough, "9"},
+	} {
+		if attribute.set {
+			params = append(params, attribute.param)
+		}
+	}
+
+	params = append(params, s.Foreground.sgr(30)...)
+	params = append(params, s.Background.sgr(40)...)
+
+	return strings.Join(params, ";")
+}
+
+// CSS returns the CSS declarations rendering the style.
+func (s Style) CSS() string {
+	var declarations []string
+
+	// Inverted text swaps the colours, including the defaults of the page.
+	foreground := s.Foreground.CSS("")
+	background := s.Background.CSS("")
+
+	if s.Inverse {
+		foreground = s.Background.CSS("Canvas")
+		background = s.Foreground.CSS("CanvasText")
+	}
+
+	if foreground != "" {
+		declarations = append(declarations, "color: "+foreground)
+	}
+
+	if background != "" {
+		declarations = append(declarations, "background-color: "+background)
+	}
+
+	if s.Bold {
+		declarations = append(declarations, "font-weight: bold")
+	}
+
+	if s.Faint {
+		declarations = append(declarations, "opacity: 0.7")
+	}
+
+	if s.Italic {
+		declarations = append(declarations, "font-style: italic")
+	}
+
+	switch {
+	case s.Underline && s.Strikethrough:
+		declarations = append(declarations, "text-decoration: underline line-through")
+	case s.Underline:
+		declarations = append(declarations, "text-decoration: underline")
+	case s.Strikethrough:
+		declarations = append(declarations, "text-decoration: line-through")
+	}
+
+	if s.Hidden {
+		declarations = append(declarations, "visibility: hidden")
+	}
+
+	return strings.Join(declarations, "; ")
+}
+
+// Apply applies the parameters of an SGR sequence, e.g. "1;31", to the style.
+// An empty sequence resets the style, and unsupported parameters are ignored.
+func (s *Style) Apply(sgr string) {
+	params := strings.Split(sgr, ";")
+
+	for i := 0; i < len(params); i++ {
+		// Parameters may have colon-separated subparameters, e.g. "38:5:196".
+		subparams := strings.Split(params[i], ":")
+
+		// An empty parameter is a reset, as is an empty sequence.
+		code, err := strconv.Atoi(subparams[0])
+		if err != nil && subparams[0] != "" {
+			continue
+		}
+
+		switch {
+		case code == 0:
+			*s = Style{}
+		case code == 1:
+			s.Bold = true
+		case code == 2:
+			s.Faint = true
+		case code == 3:
+			s.Italic = true
+		case code == 4:
+			// "4:0" turns underlining off, and other styles of underline
+			// are shown as a single underline.
+			s.Underline = len(subparams) < 2 || subparams[1] != "0"
+		case code == 5 || code == 6:
+			s.Blink = true
+		case code == 7:
+			s.Inverse = true
+		case code == 8:
+			s.Hidden = true
+		case code == 9:
+			s.Strikethrough = true
+		case code == 21:
+			s.Underline = true
+		case code == 22:
+			s.Bold = false
+			s.Faint = false
+		case code == 23:
+			s.Italic = false
+		case code == 24:
+			s.Underline = false
+		case code == 25:
+			s.Blink = false
+		case code == 27:
+			s.Inverse = false
+		case code == 28:
+			s.Hidden = false
+		case code == 29:
+			s.Strikethrough = false
+		case code >= 30 && code <= 37:
+			s.Foreground = Colour{kind: indexedColour, index: uint8(code - 30)}
+		case code == 38 || code == 48:
+			var colour Colour
+
+			if len(subparams) > 1 {
+				colour, _ = extendedColour(subparams[1:], true)
+			} else {
+				var consumed int
+
+				colour, consumed = extendedColour(params[i+1:], false)
+				i += consumed
+			}
+
+			if code == 38 {
+				s.Foreground = colour
+			} else {
+				s.Background = colour
+			}
+		case code == 39:
+			s.Foreground = Colour{}
+		case code >= 40 && code <= 47:
+			s.Background = Colour{kind: indexedColour, index: uint8(code - 40)}
+		case code == 49:
+			s.Background = Colour{}
+		case code >= 90 && code <= 97:
+			s.Foreground = Colour{kind: indexedColour, index: uint8(code - 90 + 8)}
+		case code >= 100 && code <= 107:
+			s.Background = Colour{kind: indexedColour, index: uint8(code - 100 + 8)}
+		}
+	}
+}
+
+// extendedColour parses the parameters following an extended colour parameter
+// (38 or 48): "5;n" for a colour of the palette, or "2;r;g;b" for an RGB
+// colour. RGB colours given as subparameters may also name a colour space
+// before the channels, e.g. "2::r:g:b". The number of parameters consumed is returned
+// alongside the colour, which is the default colour if invalid.
+func extendedColour(params []string, subparams bool) (Colour, int) {
+	if len(params) == 0 {
+		return Colour{}, 0
+	}
+
+	channel := func(param string) (uint8, bool) {
+		value, err := strconv.Atoi(param)
+
+		return uint8(value), err == nil && value >= 0 && value <= 255
+	}
+
+	switch params[0] {
+	case "5":
+		if len(params) < 2 {
+			return Colour{}, len(params)
+		}
+
+		index, ok := channel(params[1])
+		if !ok {
+			return Colour{}, 2
+		}
+
+		return Colour{kind: indexedColour, index: index}, 2
+	case "2":
+		channels := params[1:]
+
+		// Skip the colour space of colon-separated colours.
+		if subparams && len(channels) == 4 {
+			channels = channels[1:]
+		}
+
+		if len(channels) < 3 {
+			return Colour{}, len(params)
+		}
+
+		r, rOK := channel(channels[0])
+		g, gOK := channel(channels[1])
+		b, bOK := channel(channels[2])
+
+		if !rOK || !gOK || !bOK {
+			return Colour{}, 4
+		}
+
+		return Colour{kind: rgbColour, r: r, g: g, b: b}, 4
+	default:
+		return Colour{}, 1
+	}
+}
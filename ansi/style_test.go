@@ -0,0 +1,321 @@
+package ansi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// red is the basic red colour of the palette.
+var red = Colour{kind: indexedColour, index: 1}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		sgr   string
+		want  Style
+	}{
+		{
+			name: "attributes and colour",
+			sgr:  "1;3;31",
+			want: Style{Bold: true, Italic: true, Foreground: red},
+		},
+		{
+			name:  "empty sequence resets",
+			style: Style{Bold: true, Foreground: red},
+			sgr:   "",
+			want:  Style{},
+		},
+		{
+			name:  "reset then style",
+			style: Style{Bold: true, Foreground: red},
+			sgr:   "0;4",
+			want:  Style{Underline: true},
+		},
+		{
+			name:  "empty parameter resets",
+			style: Style{Bold: true},
+			sgr:   ";3",
+			want:  Style{Italic: true},
+		},
+		{
+			name:  "attributes turned off",
+			style: Style{Bold: true, Faint: true, Italic: true, Underline: true, Inverse: true},
+			sgr:   "22;23;24;27",
+			want:  Style{},
+		},
+		{
+			name:  "underline style off",
+			style: Style{Underline: true},
+			sgr:   "4:0",
+			want:  Style{},
+		},
+		{
+			name: "curly underline",
+			sgr:  "4:3",
+			want: Style{Underline: true},
+		},
+		{
+			name: "bright colours",
+			sgr:  "91;101",
+			want: Style{
+				Foreground: Colour{kind: indexedColour, index: 9},
+				Background: Colour{kind: indexedColour, index: 9},
+			},
+		},
+		{
+			name:  "default colours",
+			style: Style{Foreground: red, Background: red},
+			sgr:   "39;49",
+			want:  Style{},
+		},
+		{
+			name: "palette colour",
+			sgr:  "38;5;196",
+			want: Style{Foreground: Colour{kind: indexedColour, index: 196}},
+		},
+		{
+			name: "palette colour subparameters",
+			sgr:  "48:5:196",
+			want: Style{Background: Colour{kind: indexedColour, index: 196}},
+		},
+		{
+			name: "RGB colour followed by an attribute",
+			sgr:  "48;2;10;20;30;1",
+			want: Style{Background: Colour{kind: rgbColour, r: 10, g: 20, b: 30}, Bold: true},
+		},
+		{
+			name: "RGB colour subparameters with a colour space",
+			sgr:  "38:2::10:20:30",
+			want: Style{Foreground: Colour{kind: rgbColour, r: 10, g: 20, b: 30}},
+		},
+		{
+			name: "RGB colour subparameters without a colour space",
+			sgr:  "38:2:10:20:30",
+			want: Style{Foreground: Colour{kind: rgbColour, r: 10, g: 20, b: 30}},
+		},
+		{
+			name:  "out of range palette colour",
+			style: Style{Foreground: red},
+			sgr:   "38;5;256;1",
+			want:  Style{Bold: true},
+		},
+		{
+			name: "out of range RGB channel",
+			sgr:  "38;2;10;300;30;1",
+			want: Style{Bold: true},
+		},
+		{
+			name: "truncated palette colour",
+			sgr:  "38;5",
+			want: Style{},
+		},
+		{
+			name: "truncated RGB colour",
+			sgr:  "1;48;2;10;20",
+			want: Style{Bold: true},
+		},
+		{
+			name: "extended colour without a kind",
+			sgr:  "38",
+			want: Style{},
+		},
+		{
+			name: "unknown extended colour kind",
+			sgr:  "38;7;1",
+			want: Style{Bold: true},
+		},
+		{
+			name: "unsupported parameters ignored",
+			sgr:  "53;x;1",
+			want: Style{Bold: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			style := test.style
+			style.Apply(test.sgr)
+
+			if style != test.want {
+				t.Errorf("applying %q to %+v = %+v, want %+v", test.sgr, test.style, style, test.want)
+			}
+		})
+	}
+}
+
+func TestExtendedColour(t *testing.T) {
+	tests := []struct {
+		name         string
+		params       []string
+		subparams    bool
+		want         Colour
+		wantConsumed int
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:         "palette",
+			params:       []string{"5", "196", "1"},
+			want:         Colour{kind: indexedColour, index: 196},
+			wantConsumed: 2,
+		},
+		{
+			name:         "palette without an index",
+			params:       []string{"5"},
+			wantConsumed: 1,
+		},
+		{
+			name:         "palette index out of range",
+			params:       []string{"5", "256"},
+			wantConsumed: 2,
+		},
+		{
+			name:         "palette index not a number",
+			params:       []string{"5", "red"},
+			wantConsumed: 2,
+		},
+		{
+			name:         "RGB",
+			params:       []string{"2", "1", "2", "3", "1"},
+			want:         Colour{kind: rgbColour, r: 1, g: 2, b: 3},
+			wantConsumed: 4,
+		},
+		{
+			name:         "RGB with a colour space",
+			params:       []string{"2", "", "1", "2", "3"},
+			subparams:    true,
+			want:         Colour{kind: rgbColour, r: 1, g: 2, b: 3},
+			wantConsumed: 4,
+		},
+		{
+			name:         "RGB missing a channel",
+			params:       []string{"2", "1", "2"},
+			wantConsumed: 3,
+		},
+		{
+			name:         "RGB channel negative",
+			params:       []string{"2", "1", "-2", "3"},
+			wantConsumed: 4,
+		},
+		{
+			name:         "RGB channel not a number",
+			params:       []string{"2", "1", "2", "blue"},
+			wantConsumed: 4,
+		},
+		{
+			name:         "unknown kind",
+			params:       []string{"3", "1"},
+			wantConsumed: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			colour, consumed := extendedColour(test.params, test.subparams)
+			if colour != test.want || consumed != test.wantConsumed {
+				t.Errorf("extendedColour(%q, %t) = %+v, %d, want %+v, %d", test.params, test.subparams, colour, consumed, test.want, test.wantConsumed)
+			}
+		})
+	}
+}
+
+func TestStyleRoundTrip(t *testing.T) {
+	tests := []struct {
+		style Style
+		want  string
+	}{
+		{Style{}, ""},
+		{Style{Bold: true, Italic: true}, "1;3"},
+		{
+			Style{
+				Bold: true, Faint: true, Italic: true, Underline: true,
+				Blink: true, Inverse: true, Hidden: true, Strikethrough: true,
+			},
+			"1;2;3;4;5;7;8;9",
+		},
+		{Style{Foreground: red, Background: Colour{kind: indexedColour, index: 7}}, "31;47"},
+		{Style{Foreground: Colour{kind: indexedColour, index: 12}}, "94"},
+		{Style{Background: Colour{kind: indexedColour, index: 8}}, "100"},
+		{Style{Foreground: Colour{kind: indexedColour, index: 200}}, "38;5;200"},
+		{Style{Underline: true, Background: Colour{kind: rgbColour, r: 1, g: 2, b: 3}}, "4;48;2;1;2;3"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			sgr := test.style.String()
+			if sgr != test.want {
+				t.Errorf("%+v.String() = %q, want %q", test.style, sgr, test.want)
+			}
+
+			style, err := ParseStyle(sgr)
+			if err != nil {
+				t.Fatalf("ParseStyle(%q): %v", sgr, err)
+			}
+
+			if style != test.style {
+				t.Errorf("ParseStyle(%q) = %+v, want %+v", sgr, style, test.style)
+			}
+		})
+	}
+}
+
+func TestParseStyleInvalid(t *testing.T) {
+	for _, sgr := range []string{"1;31m", "\x1b[1m", "bold"} {
+		if _, err := ParseStyle(sgr); !errors.Is(err, ErrInvalidStyle) {
+			t.Errorf("ParseStyle(%q) returned %v, want %v", sgr, err, ErrInvalidStyle)
+		}
+	}
+}
+
+func TestColourCSS(t *testing.T) {
+	tests := []struct {
+		name   string
+		colour Colour
+		want   string
+	}{
+		{"default", Colour{}, "inherit"},
+		{"basic", red, "#cd0000"},
+		{"bright", Colour{kind: indexedColour, index: 15}, "#ffffff"},
+		{"first of the cube", Colour{kind: indexedColour, index: 16}, "#000000"},
+		{"within the cube", Colour{kind: indexedColour, index: 110}, "#87afd7"},
+		{"last of the cube", Colour{kind: indexedColour, index: 231}, "#ffffff"},
+		{"first grey", Colour{kind: indexedColour, index: 232}, "#080808"},
+		{"last grey", Colour{kind: indexedColour, index: 255}, "#eeeeee"},
+		{"RGB", Colour{kind: rgbColour, r: 1, g: 171, b: 255}, "#01abff"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.colour.CSS("inherit"); got != test.want {
+				t.Errorf("%+v.CSS() = %q, want %q", test.colour, got, test.want)
+			}
+		})
+	}
+}
+
+func TestStyleCSS(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		want  []string
+	}{
+		{"unstyled", Style{}, nil},
+		{"colours", Style{Foreground: red, Bold: true}, []string{"color: #cd0000", "font-weight: bold"}},
+		{"inverse of the defaults", Style{Inverse: true}, []string{"color: Canvas", "background-color: CanvasText"}},
+		{"inverse", Style{Inverse: true, Foreground: red}, []string{"color: Canvas", "background-color: #cd0000"}},
+		{"decorations", Style{Underline: true, Strikethrough: true}, []string{"text-decoration: underline line-through"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			want := strings.Join(test.want, "; ")
+
+			if got := test.style.CSS(); got != want {
+				t.Errorf("%+v.CSS() = %q, want %q", test.style, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+	"github.com/crystalix007/log-viewer/store"
+)
+
+// annotationsCollection is the name of the collection holding annotations,
+// within the state directory.
+const annotationsCollection = "annotations"
+
+func (a *API) GetAnnotations(
+	ctx context.Context,
+	request GetAnnotationsRequestObject,
+) (GetAnnotationsResponseObject, error) {
+	logPath := cleanPath(request.Params.Path)
+
+	if logPath == "" {
+		return GetAnnotations400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: "Requires a non-empty log path",
+		}, nil
+	}
+
+	if !a.allowed(ctx, logPath) {
+		return GetAnnotations403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: accessDenied,
+		}, nil
+	}
+
+	return GetAnnotations200JSONResponse{
+		Annotations: a.annotationsOf(logPath, nil),
+	}, nil
+}
+
+func (a *API) PostAnnotations(
+	ctx context.Context,
+	request PostAnnotationsRequestObject,
+) (PostAnnotationsResponseObject, error) {
+	logPath := cleanPath(request.Body.Path)
+
+	if logPath == "" {
+		return PostAnnotations400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: "Requires a non-empty log path",
+		}, nil
+	}
+
+	if request.Body.Line < 1 || request.Body.Text == "" {
+		return PostAnnotations400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: "Requires a line number of at least 1 and non-empty text",
+		}, nil
+	}
+
+	if !a.allowed(ctx, logPath) {
+		return PostAnnotations403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: accessDenied,
+		}, nil
+	}
+
+	user, _ := kmiddleware.UserFromContext(ctx)
+	now := time.Now().UTC()
+
+	annotation := Annotation{
+		Id:      store.NewID(),
+		Path:    logPath,
+		Line:    request.Body.Line,
+		Text:    request.Body.Text,
+		Author:  user.Name,
+		Created: now,
+		Updated: now,
+	}
+
+	if err := a.annotations.Put(annotation.Id, annotation); err != nil {
+		return nil, err
+	}
+
+	return PostAnnotations201JSONResponse(annotation), nil
+}
+
+func (a *API) PutAnnotationsId(
+	ctx context.Context,
+	request PutAnnotationsIdRequestObject,
+) (PutAnnotationsIdResponseObject, error) {
+	if request.Body.Text == "" {
+		return PutAnnotationsId400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: "Requires non-empty text",
+		}, nil
+	}
+
+	annotation, err := a.annotations.Get(request.Id)
+	if errors.Is(err, store.ErrNotFound) || (err == nil && !a.allowed(ctx, annotation.Path)) {
+		return PutAnnotationsId404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified annotation does not exist",
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !a.mayModify(ctx, annotation) {
+		return PutAnnotationsId403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: "Only the author of an annotation may change it",
+		}, nil
+	}
+
+	annotation.Text = request.Body.Text
+	annotation.Updated = time.Now().UTC()
+
+	if err := a.annotations.Put(annotation.Id, annotation); err != nil {
+		return nil, err
+	}
+
+	return PutAnnotationsId200JSONResponse(annotation), nil
+}
+
+func (a *API) DeleteAnnotationsId(
+	ctx context.Context,
+	request DeleteAnnotationsIdRequestObject,
+) (DeleteAnnotationsIdResponseObject, error) {
+	annotation, err := a.annotations.Get(request.Id)
+	if errors.Is(err, store.ErrNotFound) || (err == nil && !a.allowed(ctx, annotation.Path)) {
+		return DeleteAnnotationsId404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified annotation does not exist",
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !a.mayModify(ctx, annotation) {
+		return DeleteAnnotationsId403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: "Only the author of an annotation may delete it",
+		}, nil
+	}
+
+	if err := a.annotations.Delete(annotation.Id); err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	}
+
+	return DeleteAnnotationsId204Response{}, nil
+}
+
+// mayModify reports whether the user making the request may update or delete
+// the annotation, i.e. whether they created it.
+func (a *API) mayModify(ctx context.Context, annotation Annotation) bool {
+	user, _ := kmiddleware.UserFromContext(ctx)
+
+	return user.Name == annotation.Author
+}
+
+// annotationsOf returns the annotations of the log at the given path, in line
+// order. If lines is non-nil, only the annotations of those lines are
+// returned.
+//
+// Paths are compared in their canonical form, so that annotations are found
+// however the path of the log is written.
+func (a *API) annotationsOf(logPath string, lines []int) []Annotation {
+	annotations := []Annotation{}
+	logPath = cleanPath(logPath)
+
+	for _, annotation := range a.annotations.List() {
+		if cleanPath(annotation.Path) != logPath {
+			continue
+		}
+
+		if lines != nil && !slices.Contains(lines, annotation.Line) {
+			continue
+		}
+
+		annotations = append(annotations, annotation)
+	}
+
+	slices.SortFunc(annotations, func(a, b Annotation) int {
+		return cmp.Or(
+			cmp.Compare(a.Line, b.Line),
+			a.Created.Compare(b.Created),
+		)
+	})
+
+	return annotations
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/crystalix007/log-viewer/backend/filesystem"
+)
+
+func TestAnnotationPaths(t *testing.T) {
+	a, _ := newFileAPI(t, map[string]string{"app.log": "line\n"})
+
+	response, err := a.PostAnnotations(context.Background(), PostAnnotationsRequestObject{
+		Body: &PostAnnotationsJSONRequestBody{Path: "/prod//app.log", Line: 1, Text: "note"},
+	})
+	if err != nil {
+		t.Fatalf("PostAnnotations: %v", err)
+	}
+
+	created, ok := response.(PostAnnotations201JSONResponse)
+	if !ok {
+		t.Fatalf("PostAnnotations returned %#v", response)
+	}
+
+	if created.Path != "prod/app.log" {
+		t.Errorf("annotation stored with the path %q, want %q", created.Path, "prod/app.log")
+	}
+
+	// However the path of the log is written, its annotations are found.
+	for _, logPath := range []string{"prod/app.log", "/prod/app.log", "prod/./app.log"} {
+		response, err := a.GetAnnotations(context.Background(), GetAnnotationsRequestObject{
+			Params: GetAnnotationsParams{Path: logPath},
+		})
+		if err != nil {
+			t.Fatalf("GetAnnotations(%q): %v", logPath, err)
+		}
+
+		if annotations := response.(GetAnnotations200JSONResponse).Annotations; len(annotations) != 1 {
+			t.Errorf("GetAnnotations(%q) listed %+v, want the annotation", logPath, annotations)
+		}
+
+		if annotations := a.annotationsOf(logPath, []int{1}); len(annotations) != 1 {
+			t.Errorf("annotationsOf(%q) = %+v, want the annotation", logPath, annotations)
+		}
+	}
+
+	response, err = a.PostAnnotations(context.Background(), PostAnnotationsRequestObject{
+		Body: &PostAnnotationsJSONRequestBody{Path: "/", Line: 1, Text: "note"},
+	})
+	if err != nil {
+		t.Fatalf("PostAnnotations: %v", err)
+	}
+
+	if _, ok := response.(PostAnnotations400JSONResponse); !ok {
+		t.Errorf("PostAnnotations on the root returned %#v, want a bad request", response)
+	}
+}
+
+func TestStateDirectoryInRoot(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, stateDirectory := range []string{dir, filepath.Join(dir, "state"), filepath.Join(dir, "a", "..", "state")} {
+		_, err := New(
+			WithRoot("prod", filesystem.New(dir)),
+			WithStateDirectory(stateDirectory),
+		)
+		if !errors.Is(err, ErrStateDirectoryInRoot) {
+			t.Errorf("New with the state directory %q: %v, want %v", stateDirectory, err, ErrStateDirectoryInRoot)
+		}
+	}
+
+	if _, err := New(
+		WithRoot("prod", filesystem.New(filepath.Join(dir, "logs"))),
+		WithStateDirectory(filepath.Join(dir, "logs-state")),
+	); err != nil {
+		t.Errorf("New with a sibling state directory: %v", err)
+	}
+}
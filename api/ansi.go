@@ -0,0 +1,20 @@
+package api
+
+import "github.com/crystalix007/log-viewer/ansi"
+
+// shouldStripANSI reports whether ANSI escape sequences are removed from the
+// contents of a log, as requested or configured by default.
+func (a *API) shouldStripANSI(stripANSI *StripANSI) bool {
+	if stripANSI != nil {
+		return *stripANSI
+	}
+
+	return a.stripANSI
+}
+
+// stripANSILines removes ANSI escape sequences from each line in place.
+func stripANSILines(lines [][]byte) {
+	for i, line := range lines {
+		lines[i] = ansi.Strip(line)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
@@ -23,12 +24,123 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ErrorCode.
+const (
+	ErrorCodeForbidden      ErrorCode = "forbidden"
+	ErrorCodeInternal       ErrorCode = "internal"
+	ErrorCodeInvalidPath    ErrorCode = "invalid_path"
+	ErrorCodeInvalidRequest ErrorCode = "invalid_request"
+	ErrorCodeNotFound       ErrorCode = "not_found"
+	ErrorCodeReadFailed     ErrorCode = "read_failed"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv    ExportFormat = "csv"
+	ExportFormatNdjson ExportFormat = "ndjson"
+	ExportFormatText   ExportFormat = "text"
+)
+
+// Defines values for LogFormat.
+const (
+	LogFormatJson   LogFormat = "json"
+	LogFormatLogfmt LogFormat = "logfmt"
+	LogFormatText   LogFormat = "text"
+)
+
+// Annotation A note on a line of a log.
+type Annotation struct {
+	// Author The user who created the annotation.
+	Author  string    `json:"author"`
+	Created time.Time `json:"created"`
+	Id      string    `json:"id"`
+
+	// Line The number of the annotated line, counting from 1.
+	Line    int       `json:"line"`
+	Path    string    `json:"path"`
+	Text    string    `json:"text"`
+	Updated time.Time `json:"updated"`
+}
+
+// AnnotationUpdate defines model for AnnotationUpdate.
+type AnnotationUpdate struct {
+	Text string `json:"text"`
+}
+
+// AuditRecord A single access to log content.
+type AuditRecord struct {
+	// Bytes The number of bytes served.
+	Bytes    int       `json:"bytes"`
+	Endpoint string    `json:"endpoint"`
+	Groups   *[]string `json:"groups,omitempty"`
+	Path     *string   `json:"path,omitempty"`
+	Query    *string   `json:"query,omitempty"`
+
+	// Range The byte range requested, if any.
+	Range  *string   `json:"range,omitempty"`
+	Status int       `json:"status"`
+	Time   time.Time `json:"time"`
+	User   string    `json:"user"`
+}
+
+// Error An error returned by any endpoint.
+type Error struct {
+	// Code A machine-readable identifier of the kind of error, which remains stable if the message changes.
+	Code ErrorCode `json:"code"`
+
+	// Message A human-readable description of the error.
+	Message string `json:"message"`
+}
+
+// ErrorCode A machine-readable identifier of the kind of error, which remains stable if the message changes.
+type ErrorCode string
+
+// ExportFormat The format of an export: parsed records as newline-delimited JSON, selected fields as CSV, or the lines as plain text.
+type ExportFormat string
+
 // LogDetails defines model for LogDetails.
 type LogDetails struct {
+	// BeingWritten Whether the log is still being written, as judged by its backend. The logs of running containers are being written. For files, this is a heuristic, that the file was modified within the last minute, and objects in a bucket are never being written.
+	BeingWritten bool `json:"being_written"`
+
 	// FileSize The size of the log file in bytes.
-	FileSize int    `json:"file_size"`
-	Name     string `json:"name"`
-	Path     string `json:"path"`
+	FileSize int `json:"file_size"`
+
+	// FirstTime The time of the first line with a timestamp, if any.
+	FirstTime *time.Time `json:"first_time,omitempty"`
+
+	// Format The format that most lines of a log are written in: JSON objects, logfmt key=value pairs, or free text.
+	Format LogFormat `json:"format"`
+
+	// Identity An opaque identifier of the current contents of the log file, derived from its size and modification time, which changes when the file does. Links to lines of a log can pin it, to detect that the lines may have moved.
+	Identity string `json:"identity"`
+
+	// LastTime The time of the last line with a timestamp, if any.
+	LastTime *time.Time `json:"last_time,omitempty"`
+
+	// Levels The number of lines logged at each level, by the normalised level. Lines without a level are not counted.
+	Levels map[string]int `json:"levels"`
+
+	// LineCount The number of lines in the log.
+	LineCount int `json:"line_count"`
+
+	// ModTime The time the log file was last modified.
+	ModTime time.Time `json:"mod_time"`
+	Name    string    `json:"name"`
+	Path    string    `json:"path"`
+}
+
+// LogEvent A Kubernetes event, positioned among the lines of a page.
+type LogEvent struct {
+	Count int `json:"count"`
+
+	// Line The index of the line within the page that the event occurred before. An index equal to the number of lines places the event after the last line.
+	Line    int       `json:"line"`
+	Message string    `json:"message"`
+	Object  string    `json:"object"`
+	Reason  string    `json:"reason"`
+	Time    time.Time `json:"time"`
+	Type    string    `json:"type"`
 }
 
 // LogFile defines model for LogFile.
@@ -36,6 +148,133 @@ type LogFile struct {
 	Dir  bool   `json:"dir"`
 	Name string `json:"name"`
 	Path string `json:"path"`
+
+	// SymlinkTarget The target of the entry, if it is a symbolic link.
+	SymlinkTarget *string `json:"symlink_target,omitempty"`
+}
+
+// LogFormat The format that most lines of a log are written in: JSON objects, logfmt key=value pairs, or free text.
+type LogFormat string
+
+// NewAnnotation defines model for NewAnnotation.
+type NewAnnotation struct {
+	Line int    `json:"line"`
+	Path string `json:"path"`
+	Text string `json:"text"`
+}
+
+// NewView defines model for NewView.
+type NewView struct {
+	Fields    *[]string  `json:"fields,omitempty"`
+	Levels    *[]string  `json:"levels,omitempty"`
+	Name      string     `json:"name"`
+	Paths     []string   `json:"paths"`
+	Search    *string    `json:"search,omitempty"`
+	SinceTime *time.Time `json:"since_time,omitempty"`
+
+	// StripAnsi Whether ANSI escape sequences are removed.
+	StripAnsi *bool `json:"strip_ansi,omitempty"`
+
+	// Structured Whether JSON lines are shown in the structured view.
+	Structured *bool      `json:"structured,omitempty"`
+	UntilTime  *time.Time `json:"until_time,omitempty"`
+}
+
+// PodDetails defines model for PodDetails.
+type PodDetails struct {
+	Containers []string `json:"containers"`
+	Name       string   `json:"name"`
+}
+
+// PodLogLine defines model for PodLogLine.
+type PodLogLine struct {
+	Container string             `json:"container"`
+	Contents  openapi_types.File `json:"contents"`
+	Pod       string             `json:"pod"`
+
+	// Time The time the line was written, if known.
+	Time *time.Time `json:"time,omitempty"`
+}
+
+// Root defines model for Root.
+type Root struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// View A saved view of one or more logs, with the filters and display options they are shown with.
+type View struct {
+	// Author The user who saved the view.
+	Author  string    `json:"author"`
+	Created time.Time `json:"created"`
+	Fields  *[]string `json:"fields,omitempty"`
+	Id      string    `json:"id"`
+	Levels  *[]string `json:"levels,omitempty"`
+
+	// Links The URLs restoring the view of each log.
+	Links     []ViewLink `json:"links"`
+	Name      string     `json:"name"`
+	Paths     []string   `json:"paths"`
+	Search    *string    `json:"search,omitempty"`
+	SinceTime *time.Time `json:"since_time,omitempty"`
+
+	// StripAnsi Whether ANSI escape sequences are removed.
+	StripAnsi bool `json:"strip_ansi"`
+
+	// Structured Whether JSON lines are shown in the structured view.
+	Structured bool       `json:"structured"`
+	UntilTime  *time.Time `json:"until_time,omitempty"`
+
+	// Url The short URL restoring the view, which shows its log, or lists the links restoring the view of each of its logs.
+	Url string `json:"url"`
+}
+
+// ViewLink defines model for ViewLink.
+type ViewLink struct {
+	Path string `json:"path"`
+	Url  string `json:"url"`
+}
+
+// FieldFilters defines model for FieldFilters.
+type FieldFilters = []string
+
+// Follow defines model for Follow.
+type Follow = bool
+
+// Levels defines model for Levels.
+type Levels = []string
+
+// Previous defines model for Previous.
+type Previous = bool
+
+// Search defines model for Search.
+type Search = string
+
+// SinceTime defines model for SinceTime.
+type SinceTime = time.Time
+
+// StripANSI defines model for StripANSI.
+type StripANSI = bool
+
+// TailLines defines model for TailLines.
+type TailLines = int64
+
+// Timestamps defines model for Timestamps.
+type Timestamps = bool
+
+// UntilTime defines model for UntilTime.
+type UntilTime = time.Time
+
+// GetAnnotationsParams defines parameters for GetAnnotations.
+type GetAnnotationsParams struct {
+	// Path The path to the log file.
+	Path string `form:"path" json:"path"`
+}
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	// Limit The maximum number of records to retrieve.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // GetLogParams defines parameters for GetLog.
@@ -44,6 +283,57 @@ type GetLogParams struct {
 	Path string `form:"path" json:"path"`
 }
 
+// GetLogBytesParams defines parameters for GetLogBytes.
+type GetLogBytesParams struct {
+	// Path The path to the log file.
+	Path string `form:"path" json:"path"`
+
+	// Range The byte ranges to serve, e.g. `bytes=1024-`.
+	Range *string `json:"Range,omitempty"`
+
+	// IfRange Only serve the byte ranges if the log still has the given ETag or modification time, and otherwise the whole log.
+	IfRange *string `json:"If-Range,omitempty"`
+
+	// IfNoneMatch Respond with Not Modified if the log has one of the given ETags.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince Respond with Not Modified if the log has not been modified since the given time.
+	IfModifiedSince *string `json:"If-Modified-Since,omitempty"`
+}
+
+// GetLogExportParams defines parameters for GetLogExport.
+type GetLogExportParams struct {
+	// Path The path to the log file.
+	Path string `form:"path" json:"path"`
+
+	// ExportFormat The format to export the lines in. It is not named `format`, which selects the representation of pages, so that the export controls can share a form with the filters of the log page.
+	ExportFormat *ExportFormat `form:"export_format,omitempty" json:"export_format,omitempty"`
+
+	// Column The dotted paths of the fields written as the columns of a CSV export, after the line number. Defaults to the time, level and message, which are also found in unstructured lines.
+	Column *[]string `form:"column,omitempty" json:"column,omitempty"`
+
+	// Previous Return the log of the previous instance of a restarted container. Only supported by live backends.
+	Previous *Previous `form:"previous,omitempty" json:"previous,omitempty"`
+
+	// SinceTime Only return lines written at or after the given time. Live backends only stream lines written since the time, and the lines of other logs are filtered by their timestamps, where present.
+	SinceTime *SinceTime `form:"since_time,omitempty" json:"since_time,omitempty"`
+
+	// UntilTime Only return lines written before the given time, judged by their timestamps, where present.
+	UntilTime *UntilTime `form:"until_time,omitempty" json:"until_time,omitempty"`
+
+	// StripAnsi Remove ANSI escape sequences, such as colours, from the contents. Defaults to the server's configuration.
+	StripAnsi *StripANSI `form:"strip_ansi,omitempty" json:"strip_ansi,omitempty"`
+
+	// Field Only return structured (JSON) lines in which the field at a dotted path has the given value, of the form `path=value`, e.g. `level=error` or `http.status=500`. Lines must match every filter, and pages count only the matching lines.
+	Field *FieldFilters `form:"field,omitempty" json:"field,omitempty"`
+
+	// Search Only return lines containing the given text, ignoring case.
+	Search *Search `form:"search,omitempty" json:"search,omitempty"`
+
+	// Level Only return lines logged at one of the given levels: trace, debug, info, warn, error or fatal. The level of a structured line is its level field, and otherwise the first word near the start of the line naming a level, e.g. "ERROR".
+	Level *Levels `form:"level,omitempty" json:"level,omitempty"`
+}
+
 // GetLogPageParams defines parameters for GetLogPage.
 type GetLogPageParams struct {
 	// Path The path to the log file.
@@ -51,12 +341,63 @@ type GetLogPageParams struct {
 
 	// Page The page number to retrieve.
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Line Retrieve the page containing the given line number, counting from 1, instead of the page number. With field filters, this is the page containing the first matching line at or after the line.
+	Line *int `form:"line,omitempty" json:"line,omitempty"`
+
+	// Follow Wait for new lines to be written to a live log, until the requested lines are available or a timeout elapses. Only supported by live backends.
+	Follow *Follow `form:"follow,omitempty" json:"follow,omitempty"`
+
+	// Previous Return the log of the previous instance of a restarted container. Only supported by live backends.
+	Previous *Previous `form:"previous,omitempty" json:"previous,omitempty"`
+
+	// SinceTime Only return lines written at or after the given time. Live backends only stream lines written since the time, and the lines of other logs are filtered by their timestamps, where present.
+	SinceTime *SinceTime `form:"since_time,omitempty" json:"since_time,omitempty"`
+
+	// TailLines Only return the given number of lines from the end of the log. Only supported by live backends.
+	TailLines *TailLines `form:"tail_lines,omitempty" json:"tail_lines,omitempty"`
+
+	// Timestamps Prefix each line with the time it was written. Only supported by live backends.
+	Timestamps *Timestamps `form:"timestamps,omitempty" json:"timestamps,omitempty"`
+
+	// StripAnsi Remove ANSI escape sequences, such as colours, from the contents. Defaults to the server's configuration.
+	StripAnsi *StripANSI `form:"strip_ansi,omitempty" json:"strip_ansi,omitempty"`
+
+	// Field Only return structured (JSON) lines in which the field at a dotted path has the given value, of the form `path=value`, e.g. `level=error` or `http.status=500`. Lines must match every filter, and pages count only the matching lines.
+	Field *FieldFilters `form:"field,omitempty" json:"field,omitempty"`
+
+	// Search Only return lines containing the given text, ignoring case.
+	Search *Search `form:"search,omitempty" json:"search,omitempty"`
+
+	// Level Only return lines logged at one of the given levels: trace, debug, info, warn, error or fatal. The level of a structured line is its level field, and otherwise the first word near the start of the line naming a level, e.g. "ERROR".
+	Level *Levels `form:"level,omitempty" json:"level,omitempty"`
+
+	// UntilTime Only return lines written before the given time, judged by their timestamps, where present.
+	UntilTime *UntilTime `form:"until_time,omitempty" json:"until_time,omitempty"`
 }
 
 // GetLogRawParams defines parameters for GetLogRaw.
 type GetLogRawParams struct {
 	// Path The path to the log file.
 	Path string `form:"path" json:"path"`
+
+	// Follow Wait for new lines to be written to a live log, until the requested lines are available or a timeout elapses. Only supported by live backends.
+	Follow *Follow `form:"follow,omitempty" json:"follow,omitempty"`
+
+	// Previous Return the log of the previous instance of a restarted container. Only supported by live backends.
+	Previous *Previous `form:"previous,omitempty" json:"previous,omitempty"`
+
+	// SinceTime Only return lines written at or after the given time. Live backends only stream lines written since the time, and the lines of other logs are filtered by their timestamps, where present.
+	SinceTime *SinceTime `form:"since_time,omitempty" json:"since_time,omitempty"`
+
+	// TailLines Only return the given number of lines from the end of the log. Only supported by live backends.
+	TailLines *TailLines `form:"tail_lines,omitempty" json:"tail_lines,omitempty"`
+
+	// Timestamps Prefix each line with the time it was written. Only supported by live backends.
+	Timestamps *Timestamps `form:"timestamps,omitempty" json:"timestamps,omitempty"`
+
+	// StripAnsi Remove ANSI escape sequences, such as colours, from the contents. Defaults to the server's configuration.
+	StripAnsi *StripANSI `form:"strip_ansi,omitempty" json:"strip_ansi,omitempty"`
 }
 
 // GetLogsParams defines parameters for GetLogs.
@@ -65,6 +406,45 @@ type GetLogsParams struct {
 	Path *string `form:"path,omitempty" json:"path,omitempty"`
 }
 
+// GetPodsLogsParams defines parameters for GetPodsLogs.
+type GetPodsLogsParams struct {
+	// Root The root containing the pods.
+	Root *string `form:"root,omitempty" json:"root,omitempty"`
+
+	// Namespace The namespace of the pods, which is a single segment of their paths, so must not contain slashes or be "." or "..".
+	Namespace string `form:"namespace" json:"namespace"`
+
+	// Selector The label selector to match pods against.
+	Selector string `form:"selector" json:"selector"`
+
+	// Page The page number to retrieve.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Cursor Resume from where the previous page ended, as given by its `next_cursor`, rather than reading every log from the start to find the page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// SinceTime Only return lines written at or after the given time. Live backends only stream lines written since the time, and the lines of other logs are filtered by their timestamps, where present.
+	SinceTime *SinceTime `form:"since_time,omitempty" json:"since_time,omitempty"`
+
+	// StripAnsi Remove ANSI escape sequences, such as colours, from the contents. Defaults to the server's configuration.
+	StripAnsi *StripANSI `form:"strip_ansi,omitempty" json:"strip_ansi,omitempty"`
+}
+
+// GetViewsParams defines parameters for GetViews.
+type GetViewsParams struct {
+	// Id Only get the view with the given ID.
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
+// PostAnnotationsJSONRequestBody defines body for PostAnnotations for application/json ContentType.
+type PostAnnotationsJSONRequestBody = NewAnnotation
+
+// PutAnnotationsIdJSONRequestBody defines body for PutAnnotationsId for application/json ContentType.
+type PutAnnotationsIdJSONRequestBody = AnnotationUpdate
+
+// PostViewsJSONRequestBody defines body for PostViews for application/json ContentType.
+type PostViewsJSONRequestBody = NewView
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -138,9 +518,34 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAnnotations request
+	GetAnnotations(ctx context.Context, params *GetAnnotationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAnnotationsWithBody request with any body
+	PostAnnotationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAnnotations(ctx context.Context, body PostAnnotationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAnnotationsId request
+	DeleteAnnotationsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAnnotationsIdWithBody request with any body
+	PutAnnotationsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAnnotationsId(ctx context.Context, id string, body PutAnnotationsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAudit request
+	GetAudit(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLog request
 	GetLog(ctx context.Context, params *GetLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLogBytes request
+	GetLogBytes(ctx context.Context, params *GetLogBytesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLogExport request
+	GetLogExport(ctx context.Context, params *GetLogExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLogPage request
 	GetLogPage(ctx context.Context, params *GetLogPageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -149,10 +554,27 @@ type ClientInterface interface {
 
 	// GetLogs request
 	GetLogs(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPodsLogs request
+	GetPodsLogs(ctx context.Context, params *GetPodsLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRoots request
+	GetRoots(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetViews request
+	GetViews(ctx context.Context, params *GetViewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostViewsWithBody request with any body
+	PostViewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostViews(ctx context.Context, body PostViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteViewsId request
+	DeleteViewsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetLog(ctx context.Context, params *GetLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLogRequest(c.Server, params)
+func (c *Client) GetAnnotations(ctx context.Context, params *GetAnnotationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAnnotationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -163,8 +585,8 @@ func (c *Client) GetLog(ctx context.Context, params *GetLogParams, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLogPage(ctx context.Context, params *GetLogPageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLogPageRequest(c.Server, params)
+func (c *Client) PostAnnotationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAnnotationsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -175,8 +597,8 @@ func (c *Client) GetLogPage(ctx context.Context, params *GetLogPageParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLogRaw(ctx context.Context, params *GetLogRawParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLogRawRequest(c.Server, params)
+func (c *Client) PostAnnotations(ctx context.Context, body PostAnnotationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAnnotationsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -187,8 +609,8 @@ func (c *Client) GetLogRaw(ctx context.Context, params *GetLogRawParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetLogs(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetLogsRequest(c.Server, params)
+func (c *Client) DeleteAnnotationsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAnnotationsIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -199,122 +621,196 @@ func (c *Client) GetLogs(ctx context.Context, params *GetLogsParams, reqEditors
 	return c.Client.Do(req)
 }
 
-// NewGetLogRequest generates requests for GetLog
-func NewGetLogRequest(server string, params *GetLogParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutAnnotationsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAnnotationsIdRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/log")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutAnnotationsId(ctx context.Context, id string, body PutAnnotationsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAnnotationsIdRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetAudit(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetLogPageRequest generates requests for GetLogPage
-func NewGetLogPageRequest(server string, params *GetLogPageParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetLog(ctx context.Context, params *GetLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/log/page")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetLogBytes(ctx context.Context, params *GetLogBytesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogBytesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetLogExport(ctx context.Context, params *GetLogExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogExportRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetLogRawRequest generates requests for GetLogRaw
-func NewGetLogRawRequest(server string, params *GetLogRawParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetLogPage(ctx context.Context, params *GetLogPageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogPageRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/log/raw")
+func (c *Client) GetLogRaw(ctx context.Context, params *GetLogRawParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogRawRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLogs(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPodsLogs(ctx context.Context, params *GetPodsLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPodsLogsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRoots(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRootsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetViews(ctx context.Context, params *GetViewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetViewsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostViewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostViewsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostViews(ctx context.Context, body PostViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostViewsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteViewsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteViewsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAnnotationsRequest generates requests for GetAnnotations
+func NewGetAnnotationsRequest(server string, params *GetAnnotationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/annotations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -350,8 +846,19 @@ func NewGetLogRawRequest(server string, params *GetLogRawParams) (*http.Request,
 	return req, nil
 }
 
-// NewGetLogsRequest generates requests for GetLogs
-func NewGetLogsRequest(server string, params *GetLogsParams) (*http.Request, error) {
+// NewPostAnnotationsRequest calls the generic PostAnnotations builder with application/json body
+func NewPostAnnotationsRequest(server string, body PostAnnotationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAnnotationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAnnotationsRequestWithBody generates requests for PostAnnotations with any type of body
+func NewPostAnnotationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -359,7 +866,7 @@ func NewGetLogsRequest(server string, params *GetLogsParams) (*http.Request, err
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/logs")
+	operationPath := fmt.Sprintf("/annotations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -369,942 +876,4588 @@ func NewGetLogsRequest(server string, params *GetLogsParams) (*http.Request, err
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Path != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewDeleteAnnotationsIdRequest generates requests for DeleteAnnotationsId
+func NewDeleteAnnotationsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	operationPath := fmt.Sprintf("/annotations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewPutAnnotationsIdRequest calls the generic PutAnnotationsId builder with application/json body
+func NewPutAnnotationsIdRequest(server string, id string, body PutAnnotationsIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAnnotationsIdRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetLogWithResponse request
-	GetLogWithResponse(ctx context.Context, params *GetLogParams, reqEditors ...RequestEditorFn) (*GetLogResponse, error)
-
-	// GetLogPageWithResponse request
-	GetLogPageWithResponse(ctx context.Context, params *GetLogPageParams, reqEditors ...RequestEditorFn) (*GetLogPageResponse, error)
-
-	// GetLogRawWithResponse request
-	GetLogRawWithResponse(ctx context.Context, params *GetLogRawParams, reqEditors ...RequestEditorFn) (*GetLogRawResponse, error)
+// NewPutAnnotationsIdRequestWithBody generates requests for PutAnnotationsId with any type of body
+func NewPutAnnotationsIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetLogsWithResponse request
-	GetLogsWithResponse(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*GetLogsResponse, error)
-}
+	var pathParam0 string
 
-type GetLogResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LogDetails
-	JSON400      *struct {
-		Message string `json:"message"`
-	}
-	JSON404 *struct {
-		Message string `json:"message"`
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetLogResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLogResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/annotations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetLogPageResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Contents     openapi_types.File `json:"contents"`
-		NextPage     *int               `json:"next_page,omitempty"`
-		Page         int                `json:"page"`
-		Path         string             `json:"path"`
-		PreviousPage *int               `json:"previous_page,omitempty"`
-	}
-	JSON400 *struct {
-		Message string `json:"message"`
-	}
-	JSON404 *struct {
-		Message string `json:"message"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetLogPageResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLogPageResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type GetLogRawResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Contents openapi_types.File `json:"contents"`
-	}
-	JSON400 *struct {
-		Message string `json:"message"`
+// NewGetAuditRequest generates requests for GetAudit
+func NewGetAuditRequest(server string, params *GetAuditParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Message string `json:"message"`
+
+	operationPath := fmt.Sprintf("/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetLogRawResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLogRawResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type GetLogsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Logfiles []LogFile `json:"logfiles"`
-	}
-	JSON400 *struct {
-		Message string `json:"message"`
-	}
-	JSON404 *struct {
-		Message string `json:"message"`
-	}
-	JSON500 *struct {
-		Message string `json:"message"`
-	}
-}
+		if params.Limit != nil {
 
-// Status returns HTTPResponse.Status
-func (r GetLogsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLogsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return 0
-}
 
-// GetLogWithResponse request returning *GetLogResponse
-func (c *ClientWithResponses) GetLogWithResponse(ctx context.Context, params *GetLogParams, reqEditors ...RequestEditorFn) (*GetLogResponse, error) {
-	rsp, err := c.GetLog(ctx, params, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLogResponse(rsp)
+
+	return req, nil
 }
 
-// GetLogPageWithResponse request returning *GetLogPageResponse
-func (c *ClientWithResponses) GetLogPageWithResponse(ctx context.Context, params *GetLogPageParams, reqEditors ...RequestEditorFn) (*GetLogPageResponse, error) {
-	rsp, err := c.GetLogPage(ctx, params, reqEditors...)
+// NewGetLogRequest generates requests for GetLog
+func NewGetLogRequest(server string, params *GetLogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLogPageResponse(rsp)
-}
 
-// GetLogRawWithResponse request returning *GetLogRawResponse
-func (c *ClientWithResponses) GetLogRawWithResponse(ctx context.Context, params *GetLogRawParams, reqEditors ...RequestEditorFn) (*GetLogRawResponse, error) {
-	rsp, err := c.GetLogRaw(ctx, params, reqEditors...)
+	operationPath := fmt.Sprintf("/log")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLogRawResponse(rsp)
-}
 
-// GetLogsWithResponse request returning *GetLogsResponse
-func (c *ClientWithResponses) GetLogsWithResponse(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*GetLogsResponse, error) {
-	rsp, err := c.GetLogs(ctx, params, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetLogsResponse(rsp)
+
+	return req, nil
 }
 
-// ParseGetLogResponse parses an HTTP response from a GetLogWithResponse call
-func ParseGetLogResponse(rsp *http.Response) (*GetLogResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetLogBytesRequest generates requests for GetLogBytes
+func NewGetLogBytesRequest(server string, params *GetLogBytesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLogResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/log/bytes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LogDetails
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
 			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON404 = &dest
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return response, nil
-}
-
-// ParseGetLogPageResponse parses an HTTP response from a GetLogPageWithResponse call
-func ParseGetLogPageResponse(rsp *http.Response) (*GetLogPageResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLogPageResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Contents     openapi_types.File `json:"contents"`
-			NextPage     *int               `json:"next_page,omitempty"`
-			Page         int                `json:"page"`
-			Path         string             `json:"path"`
-			PreviousPage *int               `json:"previous_page,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+		if params.Range != nil {
+			var headerParam0 string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Message string `json:"message"`
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Range", headerParam0)
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+
+		if params.IfRange != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Range", runtime.ParamLocationHeader, *params.IfRange)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Range", headerParam1)
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Message string `json:"message"`
+		if params.IfNoneMatch != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam2)
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+
+		if params.IfModifiedSince != nil {
+			var headerParam3 string
+
+			headerParam3, err = runtime.StyleParamWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, *params.IfModifiedSince)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Modified-Since", headerParam3)
 		}
-		response.JSON404 = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseGetLogRawResponse parses an HTTP response from a GetLogRawWithResponse call
-func ParseGetLogRawResponse(rsp *http.Response) (*GetLogRawResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewGetLogExportRequest generates requests for GetLogExport
+func NewGetLogExportRequest(server string, params *GetLogExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLogRawResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/log/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Contents openapi_types.File `json:"contents"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Message string `json:"message"`
+		if params.ExportFormat != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "export_format", runtime.ParamLocationQuery, *params.ExportFormat); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+
+		if params.Column != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "column", runtime.ParamLocationQuery, *params.Column); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Previous != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "previous", runtime.ParamLocationQuery, *params.Previous); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SinceTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since_time", runtime.ParamLocationQuery, *params.SinceTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UntilTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until_time", runtime.ParamLocationQuery, *params.UntilTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StripAnsi != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "strip_ansi", runtime.ParamLocationQuery, *params.StripAnsi); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Field != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "field", runtime.ParamLocationQuery, *params.Field); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Level != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "level", runtime.ParamLocationQuery, *params.Level); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLogPageRequest generates requests for GetLogPage
+func NewGetLogPageRequest(server string, params *GetLogPageParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/log/page")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Line != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "line", runtime.ParamLocationQuery, *params.Line); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Follow != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Previous != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "previous", runtime.ParamLocationQuery, *params.Previous); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SinceTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since_time", runtime.ParamLocationQuery, *params.SinceTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TailLines != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tail_lines", runtime.ParamLocationQuery, *params.TailLines); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timestamps != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timestamps", runtime.ParamLocationQuery, *params.Timestamps); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StripAnsi != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "strip_ansi", runtime.ParamLocationQuery, *params.StripAnsi); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Field != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "field", runtime.ParamLocationQuery, *params.Field); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Level != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "level", runtime.ParamLocationQuery, *params.Level); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UntilTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until_time", runtime.ParamLocationQuery, *params.UntilTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLogRawRequest generates requests for GetLogRaw
+func NewGetLogRawRequest(server string, params *GetLogRawParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/log/raw")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, params.Path); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Follow != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "follow", runtime.ParamLocationQuery, *params.Follow); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Previous != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "previous", runtime.ParamLocationQuery, *params.Previous); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SinceTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since_time", runtime.ParamLocationQuery, *params.SinceTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TailLines != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tail_lines", runtime.ParamLocationQuery, *params.TailLines); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timestamps != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timestamps", runtime.ParamLocationQuery, *params.Timestamps); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StripAnsi != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "strip_ansi", runtime.ParamLocationQuery, *params.StripAnsi); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLogsRequest generates requests for GetLogs
+func NewGetLogsRequest(server string, params *GetLogsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/logs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Path != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "path", runtime.ParamLocationQuery, *params.Path); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPodsLogsRequest generates requests for GetPodsLogs
+func NewGetPodsLogsRequest(server string, params *GetPodsLogsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pods/logs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Root != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "root", runtime.ParamLocationQuery, *params.Root); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namespace", runtime.ParamLocationQuery, params.Namespace); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "selector", runtime.ParamLocationQuery, params.Selector); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SinceTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since_time", runtime.ParamLocationQuery, *params.SinceTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StripAnsi != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "strip_ansi", runtime.ParamLocationQuery, *params.StripAnsi); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRootsRequest generates requests for GetRoots
+func NewGetRootsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/roots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetViewsRequest generates requests for GetViews
+func NewGetViewsRequest(server string, params *GetViewsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/views")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostViewsRequest calls the generic PostViews builder with application/json body
+func NewPostViewsRequest(server string, body PostViewsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostViewsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostViewsRequestWithBody generates requests for PostViews with any type of body
+func NewPostViewsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/views")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteViewsIdRequest generates requests for DeleteViewsId
+func NewDeleteViewsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/views/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAnnotationsWithResponse request
+	GetAnnotationsWithResponse(ctx context.Context, params *GetAnnotationsParams, reqEditors ...RequestEditorFn) (*GetAnnotationsResponse, error)
+
+	// PostAnnotationsWithBodyWithResponse request with any body
+	PostAnnotationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAnnotationsResponse, error)
+
+	PostAnnotationsWithResponse(ctx context.Context, body PostAnnotationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAnnotationsResponse, error)
+
+	// DeleteAnnotationsIdWithResponse request
+	DeleteAnnotationsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAnnotationsIdResponse, error)
+
+	// PutAnnotationsIdWithBodyWithResponse request with any body
+	PutAnnotationsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAnnotationsIdResponse, error)
+
+	PutAnnotationsIdWithResponse(ctx context.Context, id string, body PutAnnotationsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAnnotationsIdResponse, error)
+
+	// GetAuditWithResponse request
+	GetAuditWithResponse(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*GetAuditResponse, error)
+
+	// GetLogWithResponse request
+	GetLogWithResponse(ctx context.Context, params *GetLogParams, reqEditors ...RequestEditorFn) (*GetLogResponse, error)
+
+	// GetLogBytesWithResponse request
+	GetLogBytesWithResponse(ctx context.Context, params *GetLogBytesParams, reqEditors ...RequestEditorFn) (*GetLogBytesResponse, error)
+
+	// GetLogExportWithResponse request
+	GetLogExportWithResponse(ctx context.Context, params *GetLogExportParams, reqEditors ...RequestEditorFn) (*GetLogExportResponse, error)
+
+	// GetLogPageWithResponse request
+	GetLogPageWithResponse(ctx context.Context, params *GetLogPageParams, reqEditors ...RequestEditorFn) (*GetLogPageResponse, error)
+
+	// GetLogRawWithResponse request
+	GetLogRawWithResponse(ctx context.Context, params *GetLogRawParams, reqEditors ...RequestEditorFn) (*GetLogRawResponse, error)
+
+	// GetLogsWithResponse request
+	GetLogsWithResponse(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*GetLogsResponse, error)
+
+	// GetPodsLogsWithResponse request
+	GetPodsLogsWithResponse(ctx context.Context, params *GetPodsLogsParams, reqEditors ...RequestEditorFn) (*GetPodsLogsResponse, error)
+
+	// GetRootsWithResponse request
+	GetRootsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRootsResponse, error)
+
+	// GetViewsWithResponse request
+	GetViewsWithResponse(ctx context.Context, params *GetViewsParams, reqEditors ...RequestEditorFn) (*GetViewsResponse, error)
+
+	// PostViewsWithBodyWithResponse request with any body
+	PostViewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostViewsResponse, error)
+
+	PostViewsWithResponse(ctx context.Context, body PostViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostViewsResponse, error)
+
+	// DeleteViewsIdWithResponse request
+	DeleteViewsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteViewsIdResponse, error)
+}
+
+type GetAnnotationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Annotations []Annotation `json:"annotations"`
+	}
+	JSON400 *Error
+	JSON403 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnnotationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnnotationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAnnotationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Annotation
+	JSON400      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAnnotationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAnnotationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAnnotationsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAnnotationsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAnnotationsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAnnotationsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Annotation
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAnnotationsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAnnotationsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuditResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Records []AuditRecord `json:"records"`
+	}
+	JSON403 *Error
+	JSON404 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LogDetails
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogBytesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogBytesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogBytesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogPageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Annotations The annotations on the lines of this page.
+		Annotations *[]Annotation      `json:"annotations,omitempty"`
+		Contents    openapi_types.File `json:"contents"`
+
+		// Events The events of the pod whose log is being viewed, which occurred during this page. Events are only retrieved for container logs served by a backend holding pods, such as the Kubernetes API server, or archived beside a dump of the pod's events.
+		Events *[]LogEvent `json:"events,omitempty"`
+
+		// LineNumbers The number of each line of the contents within the log, counting from 1.
+		LineNumbers  []int  `json:"line_numbers"`
+		NextPage     *int   `json:"next_page,omitempty"`
+		Page         int    `json:"page"`
+		Path         string `json:"path"`
+		PreviousPage *int   `json:"previous_page,omitempty"`
+
+		// Redacted Whether any secrets or personal data were masked in the contents.
+		Redacted bool `json:"redacted"`
+	}
+	JSON400 *Error
+	JSON403 *Error
+	JSON404 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogPageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogPageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogRawResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Contents openapi_types.File `json:"contents"`
+
+		// Redacted Whether any secrets or personal data were masked in the contents.
+		Redacted bool `json:"redacted"`
+	}
+	JSON400 *Error
+	JSON403 *Error
+	JSON404 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogRawResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogRawResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Logfiles []LogFile `json:"logfiles"`
+	}
+	JSON400 *Error
+	JSON403 *Error
+	JSON404 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPodsLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Events The events of the selected pods, which occurred during this page.
+		Events    *[]LogEvent  `json:"events,omitempty"`
+		Lines     []PodLogLine `json:"lines"`
+		Namespace string       `json:"namespace"`
+
+		// NextCursor An opaque cursor recording how far each log has been read, to request the next page with.
+		NextCursor   *string      `json:"next_cursor,omitempty"`
+		NextPage     *int         `json:"next_page,omitempty"`
+		Page         int          `json:"page"`
+		Pods         []PodDetails `json:"pods"`
+		PreviousPage *int         `json:"previous_page,omitempty"`
+
+		// Redacted Whether any secrets or personal data were masked in the lines.
+		Redacted bool   `json:"redacted"`
+		Root     string `json:"root"`
+		Selector string `json:"selector"`
+	}
+	JSON400 *Error
+	JSON403 *Error
+	JSON404 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPodsLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPodsLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRootsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Roots []Root `json:"roots"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRootsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRootsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetViewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Views []View `json:"views"`
+	}
+	JSON404 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetViewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetViewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostViewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *View
+	JSON400      *Error
+	JSON403      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostViewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostViewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteViewsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteViewsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteViewsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAnnotationsWithResponse request returning *GetAnnotationsResponse
+func (c *ClientWithResponses) GetAnnotationsWithResponse(ctx context.Context, params *GetAnnotationsParams, reqEditors ...RequestEditorFn) (*GetAnnotationsResponse, error) {
+	rsp, err := c.GetAnnotations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnnotationsResponse(rsp)
+}
+
+// PostAnnotationsWithBodyWithResponse request with arbitrary body returning *PostAnnotationsResponse
+func (c *ClientWithResponses) PostAnnotationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAnnotationsResponse, error) {
+	rsp, err := c.PostAnnotationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAnnotationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAnnotationsWithResponse(ctx context.Context, body PostAnnotationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAnnotationsResponse, error) {
+	rsp, err := c.PostAnnotations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAnnotationsResponse(rsp)
+}
+
+// DeleteAnnotationsIdWithResponse request returning *DeleteAnnotationsIdResponse
+func (c *ClientWithResponses) DeleteAnnotationsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAnnotationsIdResponse, error) {
+	rsp, err := c.DeleteAnnotationsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAnnotationsIdResponse(rsp)
+}
+
+// PutAnnotationsIdWithBodyWithResponse request with arbitrary body returning *PutAnnotationsIdResponse
+func (c *ClientWithResponses) PutAnnotationsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAnnotationsIdResponse, error) {
+	rsp, err := c.PutAnnotationsIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAnnotationsIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAnnotationsIdWithResponse(ctx context.Context, id string, body PutAnnotationsIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAnnotationsIdResponse, error) {
+	rsp, err := c.PutAnnotationsId(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAnnotationsIdResponse(rsp)
+}
+
+// GetAuditWithResponse request returning *GetAuditResponse
+func (c *ClientWithResponses) GetAuditWithResponse(ctx context.Context, params *GetAuditParams, reqEditors ...RequestEditorFn) (*GetAuditResponse, error) {
+	rsp, err := c.GetAudit(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditResponse(rsp)
+}
+
+// GetLogWithResponse request returning *GetLogResponse
+func (c *ClientWithResponses) GetLogWithResponse(ctx context.Context, params *GetLogParams, reqEditors ...RequestEditorFn) (*GetLogResponse, error) {
+	rsp, err := c.GetLog(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogResponse(rsp)
+}
+
+// GetLogBytesWithResponse request returning *GetLogBytesResponse
+func (c *ClientWithResponses) GetLogBytesWithResponse(ctx context.Context, params *GetLogBytesParams, reqEditors ...RequestEditorFn) (*GetLogBytesResponse, error) {
+	rsp, err := c.GetLogBytes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogBytesResponse(rsp)
+}
+
+// GetLogExportWithResponse request returning *GetLogExportResponse
+func (c *ClientWithResponses) GetLogExportWithResponse(ctx context.Context, params *GetLogExportParams, reqEditors ...RequestEditorFn) (*GetLogExportResponse, error) {
+	rsp, err := c.GetLogExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogExportResponse(rsp)
+}
+
+// GetLogPageWithResponse request returning *GetLogPageResponse
+func (c *ClientWithResponses) GetLogPageWithResponse(ctx context.Context, params *GetLogPageParams, reqEditors ...RequestEditorFn) (*GetLogPageResponse, error) {
+	rsp, err := c.GetLogPage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogPageResponse(rsp)
+}
+
+// GetLogRawWithResponse request returning *GetLogRawResponse
+func (c *ClientWithResponses) GetLogRawWithResponse(ctx context.Context, params *GetLogRawParams, reqEditors ...RequestEditorFn) (*GetLogRawResponse, error) {
+	rsp, err := c.GetLogRaw(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogRawResponse(rsp)
+}
+
+// GetLogsWithResponse request returning *GetLogsResponse
+func (c *ClientWithResponses) GetLogsWithResponse(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*GetLogsResponse, error) {
+	rsp, err := c.GetLogs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogsResponse(rsp)
+}
+
+// GetPodsLogsWithResponse request returning *GetPodsLogsResponse
+func (c *ClientWithResponses) GetPodsLogsWithResponse(ctx context.Context, params *GetPodsLogsParams, reqEditors ...RequestEditorFn) (*GetPodsLogsResponse, error) {
+	rsp, err := c.GetPodsLogs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPodsLogsResponse(rsp)
+}
+
+// GetRootsWithResponse request returning *GetRootsResponse
+func (c *ClientWithResponses) GetRootsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRootsResponse, error) {
+	rsp, err := c.GetRoots(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRootsResponse(rsp)
+}
+
+// GetViewsWithResponse request returning *GetViewsResponse
+func (c *ClientWithResponses) GetViewsWithResponse(ctx context.Context, params *GetViewsParams, reqEditors ...RequestEditorFn) (*GetViewsResponse, error) {
+	rsp, err := c.GetViews(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetViewsResponse(rsp)
+}
+
+// PostViewsWithBodyWithResponse request with arbitrary body returning *PostViewsResponse
+func (c *ClientWithResponses) PostViewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostViewsResponse, error) {
+	rsp, err := c.PostViewsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostViewsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostViewsWithResponse(ctx context.Context, body PostViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostViewsResponse, error) {
+	rsp, err := c.PostViews(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostViewsResponse(rsp)
+}
+
+// DeleteViewsIdWithResponse request returning *DeleteViewsIdResponse
+func (c *ClientWithResponses) DeleteViewsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteViewsIdResponse, error) {
+	rsp, err := c.DeleteViewsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteViewsIdResponse(rsp)
+}
+
+// ParseGetAnnotationsResponse parses an HTTP response from a GetAnnotationsWithResponse call
+func ParseGetAnnotationsResponse(rsp *http.Response) (*GetAnnotationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAnnotationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Annotations []Annotation `json:"annotations"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAnnotationsResponse parses an HTTP response from a PostAnnotationsWithResponse call
+func ParsePostAnnotationsResponse(rsp *http.Response) (*PostAnnotationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAnnotationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Annotation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAnnotationsIdResponse parses an HTTP response from a DeleteAnnotationsIdWithResponse call
+func ParseDeleteAnnotationsIdResponse(rsp *http.Response) (*DeleteAnnotationsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAnnotationsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAnnotationsIdResponse parses an HTTP response from a PutAnnotationsIdWithResponse call
+func ParsePutAnnotationsIdResponse(rsp *http.Response) (*PutAnnotationsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAnnotationsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Annotation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditResponse parses an HTTP response from a GetAuditWithResponse call
+func ParseGetAuditResponse(rsp *http.Response) (*GetAuditResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Records []AuditRecord `json:"records"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLogResponse parses an HTTP response from a GetLogWithResponse call
+func ParseGetLogResponse(rsp *http.Response) (*GetLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LogDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLogBytesResponse parses an HTTP response from a GetLogBytesWithResponse call
+func ParseGetLogBytesResponse(rsp *http.Response) (*GetLogBytesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLogBytesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLogExportResponse parses an HTTP response from a GetLogExportWithResponse call
+func ParseGetLogExportResponse(rsp *http.Response) (*GetLogExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLogExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLogPageResponse parses an HTTP response from a GetLogPageWithResponse call
+func ParseGetLogPageResponse(rsp *http.Response) (*GetLogPageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLogPageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Annotations The annotations on the lines of this page.
+			Annotations *[]Annotation      `json:"annotations,omitempty"`
+			Contents    openapi_types.File `json:"contents"`
+
+			// Events The events of the pod whose log is being viewed, which occurred during this page. Events are only retrieved for container logs served by a backend holding pods, such as the Kubernetes API server, or archived beside a dump of the pod's events.
+			Events *[]LogEvent `json:"events,omitempty"`
+
+			// LineNumbers The number of each line of the contents within the log, counting from 1.
+			LineNumbers  []int  `json:"line_numbers"`
+			NextPage     *int   `json:"next_page,omitempty"`
+			Page         int    `json:"page"`
+			Path         string `json:"path"`
+			PreviousPage *int   `json:"previous_page,omitempty"`
+
+			// Redacted Whether any secrets or personal data were masked in the contents.
+			Redacted bool `json:"redacted"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLogRawResponse parses an HTTP response from a GetLogRawWithResponse call
+func ParseGetLogRawResponse(rsp *http.Response) (*GetLogRawResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLogRawResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Contents openapi_types.File `json:"contents"`
+
+			// Redacted Whether any secrets or personal data were masked in the contents.
+			Redacted bool `json:"redacted"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLogsResponse parses an HTTP response from a GetLogsWithResponse call
+func ParseGetLogsResponse(rsp *http.Response) (*GetLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Logfiles []LogFile `json:"logfiles"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPodsLogsResponse parses an HTTP response from a GetPodsLogsWithResponse call
+func ParseGetPodsLogsResponse(rsp *http.Response) (*GetPodsLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPodsLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Events The events of the selected pods, which occurred during this page.
+			Events    *[]LogEvent  `json:"events,omitempty"`
+			Lines     []PodLogLine `json:"lines"`
+			Namespace string       `json:"namespace"`
+
+			// NextCursor An opaque cursor recording how far each log has been read, to request the next page with.
+			NextCursor   *string      `json:"next_cursor,omitempty"`
+			NextPage     *int         `json:"next_page,omitempty"`
+			Page         int          `json:"page"`
+			Pods         []PodDetails `json:"pods"`
+			PreviousPage *int         `json:"previous_page,omitempty"`
+
+			// Redacted Whether any secrets or personal data were masked in the lines.
+			Redacted bool   `json:"redacted"`
+			Root     string `json:"root"`
+			Selector string `json:"selector"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRootsResponse parses an HTTP response from a GetRootsWithResponse call
+func ParseGetRootsResponse(rsp *http.Response) (*GetRootsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRootsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Roots []Root `json:"roots"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetViewsResponse parses an HTTP response from a GetViewsWithResponse call
+func ParseGetViewsResponse(rsp *http.Response) (*GetViewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetViewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Views []View `json:"views"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostViewsResponse parses an HTTP response from a PostViewsWithResponse call
+func ParsePostViewsResponse(rsp *http.Response) (*PostViewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostViewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest View
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteViewsIdResponse parses an HTTP response from a DeleteViewsIdWithResponse call
+func ParseDeleteViewsIdResponse(rsp *http.Response) (*DeleteViewsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteViewsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the annotations of a log
+	// (GET /annotations)
+	GetAnnotations(w http.ResponseWriter, r *http.Request, params GetAnnotationsParams)
+	// Annotate a line of a log
+	// (POST /annotations)
+	PostAnnotations(w http.ResponseWriter, r *http.Request)
+	// Delete an annotation
+	// (DELETE /annotations/{id})
+	DeleteAnnotationsId(w http.ResponseWriter, r *http.Request, id string)
+	// Update an annotation
+	// (PUT /annotations/{id})
+	PutAnnotationsId(w http.ResponseWriter, r *http.Request, id string)
+	// Get recent audit records
+	// (GET /audit)
+	GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams)
+	// Get log details
+	// (GET /log)
+	GetLog(w http.ResponseWriter, r *http.Request, params GetLogParams)
+	// Get the bytes of a log file
+	// (GET /log/bytes)
+	GetLogBytes(w http.ResponseWriter, r *http.Request, params GetLogBytesParams)
+	// Export a log
+	// (GET /log/export)
+	GetLogExport(w http.ResponseWriter, r *http.Request, params GetLogExportParams)
+	// Get log page
+	// (GET /log/page)
+	GetLogPage(w http.ResponseWriter, r *http.Request, params GetLogPageParams)
+	// Get a log file
+	// (GET /log/raw)
+	GetLogRaw(w http.ResponseWriter, r *http.Request, params GetLogRawParams)
+	// Get a list of logs
+	// (GET /logs)
+	GetLogs(w http.ResponseWriter, r *http.Request, params GetLogsParams)
+	// Get logs of pods matching a label selector
+	// (GET /pods/logs)
+	GetPodsLogs(w http.ResponseWriter, r *http.Request, params GetPodsLogsParams)
+	// Get a list of roots
+	// (GET /roots)
+	GetRoots(w http.ResponseWriter, r *http.Request)
+	// Get the saved views
+	// (GET /views)
+	GetViews(w http.ResponseWriter, r *http.Request, params GetViewsParams)
+	// Save a view
+	// (POST /views)
+	PostViews(w http.ResponseWriter, r *http.Request)
+	// Delete a saved view
+	// (DELETE /views/{id})
+	DeleteViewsId(w http.ResponseWriter, r *http.Request, id string)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Get the annotations of a log
+// (GET /annotations)
+func (_ Unimplemented) GetAnnotations(w http.ResponseWriter, r *http.Request, params GetAnnotationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Annotate a line of a log
+// (POST /annotations)
+func (_ Unimplemented) PostAnnotations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an annotation
+// (DELETE /annotations/{id})
+func (_ Unimplemented) DeleteAnnotationsId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update an annotation
+// (PUT /annotations/{id})
+func (_ Unimplemented) PutAnnotationsId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get recent audit records
+// (GET /audit)
+func (_ Unimplemented) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get log details
+// (GET /log)
+func (_ Unimplemented) GetLog(w http.ResponseWriter, r *http.Request, params GetLogParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the bytes of a log file
+// (GET /log/bytes)
+func (_ Unimplemented) GetLogBytes(w http.ResponseWriter, r *http.Request, params GetLogBytesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export a log
+// (GET /log/export)
+func (_ Unimplemented) GetLogExport(w http.ResponseWriter, r *http.Request, params GetLogExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get log page
+// (GET /log/page)
+func (_ Unimplemented) GetLogPage(w http.ResponseWriter, r *http.Request, params GetLogPageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a log file
+// (GET /log/raw)
+func (_ Unimplemented) GetLogRaw(w http.ResponseWriter, r *http.Request, params GetLogRawParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a list of logs
+// (GET /logs)
+func (_ Unimplemented) GetLogs(w http.ResponseWriter, r *http.Request, params GetLogsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get logs of pods matching a label selector
+// (GET /pods/logs)
+func (_ Unimplemented) GetPodsLogs(w http.ResponseWriter, r *http.Request, params GetPodsLogsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a list of roots
+// (GET /roots)
+func (_ Unimplemented) GetRoots(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the saved views
+// (GET /views)
+func (_ Unimplemented) GetViews(w http.ResponseWriter, r *http.Request, params GetViewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Save a view
+// (POST /views)
+func (_ Unimplemented) PostViews(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a saved view
+// (DELETE /views/{id})
+func (_ Unimplemented) DeleteViewsId(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAnnotations operation middleware
+func (siw *ServerInterfaceWrapper) GetAnnotations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnnotationsParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAnnotations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostAnnotations operation middleware
+func (siw *ServerInterfaceWrapper) PostAnnotations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAnnotations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteAnnotationsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAnnotationsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAnnotationsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutAnnotationsId operation middleware
+func (siw *ServerInterfaceWrapper) PutAnnotationsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAnnotationsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAudit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLog operation middleware
+func (siw *ServerInterfaceWrapper) GetLog(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLog(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLogBytes operation middleware
+func (siw *ServerInterfaceWrapper) GetLogBytes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogBytesParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Range", valueList[0], &Range, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
+	// ------------- Optional header parameter "If-Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Range")]; found {
+		var IfRange string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Range", valueList[0], &IfRange, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Range", Err: err})
+			return
+		}
+
+		params.IfRange = &IfRange
+
+	}
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogBytes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLogExport operation middleware
+func (siw *ServerInterfaceWrapper) GetLogExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogExportParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "export_format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "export_format", r.URL.Query(), &params.ExportFormat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "export_format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "column" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "column", r.URL.Query(), &params.Column)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "column", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "previous" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "previous", r.URL.Query(), &params.Previous)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "previous", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since_time", r.URL.Query(), &params.SinceTime)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since_time", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until_time", r.URL.Query(), &params.UntilTime)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until_time", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "strip_ansi" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strip_ansi", r.URL.Query(), &params.StripAnsi)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "strip_ansi", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", r.URL.Query(), &params.Field)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLogPage operation middleware
+func (siw *ServerInterfaceWrapper) GetLogPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogPageParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "line" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "line", r.URL.Query(), &params.Line)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "line", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "previous" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "previous", r.URL.Query(), &params.Previous)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "previous", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since_time", r.URL.Query(), &params.SinceTime)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since_time", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tail_lines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tail_lines", r.URL.Query(), &params.TailLines)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tail_lines", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timestamps" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timestamps", r.URL.Query(), &params.Timestamps)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timestamps", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "strip_ansi" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strip_ansi", r.URL.Query(), &params.StripAnsi)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "strip_ansi", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", r.URL.Query(), &params.Field)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until_time", r.URL.Query(), &params.UntilTime)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until_time", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogPage(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLogRaw operation middleware
+func (siw *ServerInterfaceWrapper) GetLogRaw(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogRawParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "previous" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "previous", r.URL.Query(), &params.Previous)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "previous", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since_time", r.URL.Query(), &params.SinceTime)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since_time", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tail_lines" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tail_lines", r.URL.Query(), &params.TailLines)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tail_lines", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timestamps" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timestamps", r.URL.Query(), &params.Timestamps)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timestamps", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "strip_ansi" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strip_ansi", r.URL.Query(), &params.StripAnsi)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "strip_ansi", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogRaw(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogsParams
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPodsLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetPodsLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPodsLogsParams
+
+	// ------------- Optional query parameter "root" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "root", r.URL.Query(), &params.Root)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "root", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "namespace" -------------
+
+	if paramValue := r.URL.Query().Get("namespace"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "namespace"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "selector" -------------
+
+	if paramValue := r.URL.Query().Get("selector"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "selector"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "selector", r.URL.Query(), &params.Selector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "selector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since_time" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since_time", r.URL.Query(), &params.SinceTime)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since_time", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "strip_ansi" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "strip_ansi", r.URL.Query(), &params.StripAnsi)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "strip_ansi", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPodsLogs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRoots operation middleware
+func (siw *ServerInterfaceWrapper) GetRoots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRoots(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetViews operation middleware
+func (siw *ServerInterfaceWrapper) GetViews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetViewsParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetViews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PostViews operation middleware
+func (siw *ServerInterfaceWrapper) PostViews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostViews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteViewsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteViewsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteViewsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
 		}
-		response.JSON400 = &dest
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/annotations", wrapper.GetAnnotations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/annotations", wrapper.PostAnnotations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/annotations/{id}", wrapper.DeleteAnnotationsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/annotations/{id}", wrapper.PutAnnotationsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.GetAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/log", wrapper.GetLog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/log/bytes", wrapper.GetLogBytes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/log/export", wrapper.GetLogExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/log/page", wrapper.GetLogPage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/log/raw", wrapper.GetLogRaw)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/logs", wrapper.GetLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pods/logs", wrapper.GetPodsLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/roots", wrapper.GetRoots)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/views", wrapper.GetViews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/views", wrapper.PostViews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/views/{id}", wrapper.DeleteViewsId)
+	})
+
+	return r
+}
+
+type GetAnnotationsRequestObject struct {
+	Params GetAnnotationsParams
+}
+
+type GetAnnotationsResponseObject interface {
+	VisitGetAnnotationsResponse(w http.ResponseWriter) error
+}
+
+type GetAnnotations200JSONResponse struct {
+	Annotations []Annotation `json:"annotations"`
+}
+
+func (response GetAnnotations200JSONResponse) VisitGetAnnotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnnotations400JSONResponse Error
+
+func (response GetAnnotations400JSONResponse) VisitGetAnnotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnnotations403JSONResponse Error
+
+func (response GetAnnotations403JSONResponse) VisitGetAnnotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAnnotationsRequestObject struct {
+	Body *PostAnnotationsJSONRequestBody
+}
+
+type PostAnnotationsResponseObject interface {
+	VisitPostAnnotationsResponse(w http.ResponseWriter) error
+}
+
+type PostAnnotations201JSONResponse Annotation
+
+func (response PostAnnotations201JSONResponse) VisitPostAnnotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAnnotations400JSONResponse Error
+
+func (response PostAnnotations400JSONResponse) VisitPostAnnotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAnnotations403JSONResponse Error
+
+func (response PostAnnotations403JSONResponse) VisitPostAnnotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAnnotations500JSONResponse Error
+
+func (response PostAnnotations500JSONResponse) VisitPostAnnotationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAnnotationsIdRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteAnnotationsIdResponseObject interface {
+	VisitDeleteAnnotationsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAnnotationsId204Response struct {
+}
+
+func (response DeleteAnnotationsId204Response) VisitDeleteAnnotationsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAnnotationsId403JSONResponse Error
+
+func (response DeleteAnnotationsId403JSONResponse) VisitDeleteAnnotationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAnnotationsId404JSONResponse Error
+
+func (response DeleteAnnotationsId404JSONResponse) VisitDeleteAnnotationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAnnotationsId500JSONResponse Error
+
+func (response DeleteAnnotationsId500JSONResponse) VisitDeleteAnnotationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAnnotationsIdRequestObject struct {
+	Id   string `json:"id"`
+	Body *PutAnnotationsIdJSONRequestBody
+}
+
+type PutAnnotationsIdResponseObject interface {
+	VisitPutAnnotationsIdResponse(w http.ResponseWriter) error
+}
+
+type PutAnnotationsId200JSONResponse Annotation
+
+func (response PutAnnotationsId200JSONResponse) VisitPutAnnotationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAnnotationsId400JSONResponse Error
+
+func (response PutAnnotationsId400JSONResponse) VisitPutAnnotationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAnnotationsId403JSONResponse Error
+
+func (response PutAnnotationsId403JSONResponse) VisitPutAnnotationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type PutAnnotationsId404JSONResponse Error
 
-	}
+func (response PutAnnotationsId404JSONResponse) VisitPutAnnotationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetLogsResponse parses an HTTP response from a GetLogsWithResponse call
-func ParseGetLogsResponse(rsp *http.Response) (*GetLogsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PutAnnotationsId500JSONResponse Error
 
-	response := &GetLogsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response PutAnnotationsId500JSONResponse) VisitPutAnnotationsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Logfiles []LogFile `json:"logfiles"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type GetAuditRequestObject struct {
+	Params GetAuditParams
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type GetAuditResponseObject interface {
+	VisitGetAuditResponse(w http.ResponseWriter) error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest struct {
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetAudit200JSONResponse struct {
+	Records []AuditRecord `json:"records"`
+}
 
-	}
+func (response GetAudit200JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get log details
-	// (GET /log)
-	GetLog(w http.ResponseWriter, r *http.Request, params GetLogParams)
-	// Get log page
-	// (GET /log/page)
-	GetLogPage(w http.ResponseWriter, r *http.Request, params GetLogPageParams)
-	// Get a log file
-	// (GET /log/raw)
-	GetLogRaw(w http.ResponseWriter, r *http.Request, params GetLogRawParams)
-	// Get a list of logs
-	// (GET /logs)
-	GetLogs(w http.ResponseWriter, r *http.Request, params GetLogsParams)
+type GetAudit403JSONResponse Error
+
+func (response GetAudit403JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+type GetAudit404JSONResponse Error
 
-type Unimplemented struct{}
+func (response GetAudit404JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-// Get log details
-// (GET /log)
-func (_ Unimplemented) GetLog(w http.ResponseWriter, r *http.Request, params GetLogParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Get log page
-// (GET /log/page)
-func (_ Unimplemented) GetLogPage(w http.ResponseWriter, r *http.Request, params GetLogPageParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type GetLogRequestObject struct {
+	Params GetLogParams
 }
 
-// Get a log file
-// (GET /log/raw)
-func (_ Unimplemented) GetLogRaw(w http.ResponseWriter, r *http.Request, params GetLogRawParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type GetLogResponseObject interface {
+	VisitGetLogResponse(w http.ResponseWriter) error
 }
 
-// Get a list of logs
-// (GET /logs)
-func (_ Unimplemented) GetLogs(w http.ResponseWriter, r *http.Request, params GetLogsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+type GetLog200JSONResponse LogDetails
+
+func (response GetLog200JSONResponse) VisitGetLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+type GetLog400JSONResponse Error
+
+func (response GetLog400JSONResponse) VisitGetLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+type GetLog403JSONResponse Error
 
-// GetLog operation middleware
-func (siw *ServerInterfaceWrapper) GetLog(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+func (response GetLog403JSONResponse) VisitGetLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	var err error
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLogParams
+type GetLog404JSONResponse Error
 
-	// ------------- Required query parameter "path" -------------
+func (response GetLog404JSONResponse) VisitGetLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+	return json.NewEncoder(w).Encode(response)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
+type GetLogBytesRequestObject struct {
+	Params GetLogBytesParams
+}
+
+type GetLogBytesResponseObject interface {
+	VisitGetLogBytesResponse(w http.ResponseWriter) error
+}
+
+type GetLogBytes200ResponseHeaders struct {
+	AcceptRanges string
+	ETag         string
+	LastModified string
+}
+
+type GetLogBytes200TextplainCharsetUtf8Response struct {
+	Body          io.Reader
+	Headers       GetLogBytes200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetLogBytes200TextplainCharsetUtf8Response) VisitGetLogBytesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.Header().Set("Accept-Ranges", fmt.Sprint(response.Headers.AcceptRanges))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
 
-	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
 	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLog(w, r, params)
-	}))
+type GetLogBytes206TextplainCharsetUtf8Response struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+func (response GetLogBytes206TextplainCharsetUtf8Response) VisitGetLogBytesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.WriteHeader(206)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-// GetLogPage operation middleware
-func (siw *ServerInterfaceWrapper) GetLogPage(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type GetLogBytes304Response struct {
+}
 
-	var err error
+func (response GetLogBytes304Response) VisitGetLogBytesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(304)
+	return nil
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLogPageParams
+type GetLogBytes400JSONResponse Error
 
-	// ------------- Required query parameter "path" -------------
+func (response GetLogBytes400JSONResponse) VisitGetLogBytesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+	return json.NewEncoder(w).Encode(response)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
-	}
+type GetLogBytes403JSONResponse Error
 
-	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
+func (response GetLogBytes403JSONResponse) VisitGetLogBytesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	// ------------- Optional query parameter "page" -------------
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+type GetLogBytes404JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLogPage(w, r, params)
-	}))
+func (response GetLogBytes404JSONResponse) VisitGetLogBytesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+type GetLogBytes416Response struct {
 }
 
-// GetLogRaw operation middleware
-func (siw *ServerInterfaceWrapper) GetLogRaw(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+func (response GetLogBytes416Response) VisitGetLogBytesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(416)
+	return nil
+}
 
-	var err error
+type GetLogExportRequestObject struct {
+	Params GetLogExportParams
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLogRawParams
+type GetLogExportResponseObject interface {
+	VisitGetLogExportResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Required query parameter "path" -------------
+type GetLogExport200ResponseHeaders struct {
+	ContentDisposition string
+}
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+type GetLogExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       GetLogExport200ResponseHeaders
+	ContentLength int64
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
+func (response GetLogExport200ApplicationxNdjsonResponse) VisitGetLogExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
 
-	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
 	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLogRaw(w, r, params)
-	}))
+type GetLogExport200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetLogExport200ResponseHeaders
+	ContentLength int64
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+func (response GetLogExport200TextcsvResponse) VisitGetLogExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-// GetLogs operation middleware
-func (siw *ServerInterfaceWrapper) GetLogs(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+type GetLogExport200TextplainCharsetUtf8Response struct {
+	Body          io.Reader
+	Headers       GetLogExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetLogExport200TextplainCharsetUtf8Response) VisitGetLogExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	var err error
+type GetLogExport400JSONResponse Error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetLogsParams
+func (response GetLogExport400JSONResponse) VisitGetLogExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// ------------- Optional query parameter "path" -------------
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "path", r.URL.Query(), &params.Path)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
+type GetLogExport403JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLogs(w, r, params)
-	}))
+func (response GetLogExport403JSONResponse) VisitGetLogExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+type GetLogExport404JSONResponse Error
+
+func (response GetLogExport404JSONResponse) VisitGetLogExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+type GetLogPageRequestObject struct {
+	Params GetLogPageParams
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+type GetLogPageResponseObject interface {
+	VisitGetLogPageResponse(w http.ResponseWriter) error
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+type GetLogPage200JSONResponse struct {
+	// Annotations The annotations on the lines of this page.
+	Annotations *[]Annotation      `json:"annotations,omitempty"`
+	Contents    openapi_types.File `json:"contents"`
+
+	// Events The events of the pod whose log is being viewed, which occurred during this page. Events are only retrieved for container logs served by a backend holding pods, such as the Kubernetes API server, or archived beside a dump of the pod's events.
+	Events *[]LogEvent `json:"events,omitempty"`
+
+	// LineNumbers The number of each line of the contents within the log, counting from 1.
+	LineNumbers  []int  `json:"line_numbers"`
+	NextPage     *int   `json:"next_page,omitempty"`
+	Page         int    `json:"page"`
+	Path         string `json:"path"`
+	PreviousPage *int   `json:"previous_page,omitempty"`
+
+	// Redacted Whether any secrets or personal data were masked in the contents.
+	Redacted bool `json:"redacted"`
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+func (response GetLogPage200JSONResponse) VisitGetLogPageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type GetLogPage400JSONResponse Error
+
+func (response GetLogPage400JSONResponse) VisitGetLogPageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+type GetLogPage403JSONResponse Error
+
+func (response GetLogPage403JSONResponse) VisitGetLogPageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RequiredParamError struct {
-	ParamName string
+type GetLogPage404JSONResponse Error
+
+func (response GetLogPage404JSONResponse) VisitGetLogPageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type GetLogRawRequestObject struct {
+	Params GetLogRawParams
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type GetLogRawResponseObject interface {
+	VisitGetLogRawResponse(w http.ResponseWriter) error
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type GetLogRaw200JSONResponse struct {
+	Contents openapi_types.File `json:"contents"`
+
+	// Redacted Whether any secrets or personal data were masked in the contents.
+	Redacted bool `json:"redacted"`
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+func (response GetLogRaw200JSONResponse) VisitGetLogRawResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+type GetLogRaw400JSONResponse Error
+
+func (response GetLogRaw400JSONResponse) VisitGetLogRawResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+type GetLogRaw403JSONResponse Error
+
+func (response GetLogRaw403JSONResponse) VisitGetLogRawResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+type GetLogRaw404JSONResponse Error
+
+func (response GetLogRaw404JSONResponse) VisitGetLogRawResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type GetLogsRequestObject struct {
+	Params GetLogsParams
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type GetLogsResponseObject interface {
+	VisitGetLogsResponse(w http.ResponseWriter) error
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type GetLogs200JSONResponse struct {
+	Logfiles []LogFile `json:"logfiles"`
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+func (response GetLogs200JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type GetLogs400JSONResponse Error
+
+func (response GetLogs400JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type GetLogs403JSONResponse Error
+
+func (response GetLogs403JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type GetLogs404JSONResponse Error
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+func (response GetLogs404JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/log", wrapper.GetLog)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/log/page", wrapper.GetLogPage)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/log/raw", wrapper.GetLogRaw)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/logs", wrapper.GetLogs)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return r
+type GetLogs500JSONResponse Error
+
+func (response GetLogs500JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogRequestObject struct {
-	Params GetLogParams
+type GetPodsLogsRequestObject struct {
+	Params GetPodsLogsParams
 }
 
-type GetLogResponseObject interface {
-	VisitGetLogResponse(w http.ResponseWriter) error
+type GetPodsLogsResponseObject interface {
+	VisitGetPodsLogsResponse(w http.ResponseWriter) error
 }
 
-type GetLog200JSONResponse LogDetails
+type GetPodsLogs200JSONResponse struct {
+	// Events The events of the selected pods, which occurred during this page.
+	Events    *[]LogEvent  `json:"events,omitempty"`
+	Lines     []PodLogLine `json:"lines"`
+	Namespace string       `json:"namespace"`
+
+	// NextCursor An opaque cursor recording how far each log has been read, to request the next page with.
+	NextCursor   *string      `json:"next_cursor,omitempty"`
+	NextPage     *int         `json:"next_page,omitempty"`
+	Page         int          `json:"page"`
+	Pods         []PodDetails `json:"pods"`
+	PreviousPage *int         `json:"previous_page,omitempty"`
+
+	// Redacted Whether any secrets or personal data were masked in the lines.
+	Redacted bool   `json:"redacted"`
+	Root     string `json:"root"`
+	Selector string `json:"selector"`
+}
 
-func (response GetLog200JSONResponse) VisitGetLogResponse(w http.ResponseWriter) error {
+func (response GetPodsLogs200JSONResponse) VisitGetPodsLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLog400JSONResponse struct {
-	Message string `json:"message"`
-}
+type GetPodsLogs400JSONResponse Error
 
-func (response GetLog400JSONResponse) VisitGetLogResponse(w http.ResponseWriter) error {
+func (response GetPodsLogs400JSONResponse) VisitGetPodsLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLog404JSONResponse struct {
-	Message string `json:"message"`
+type GetPodsLogs403JSONResponse Error
+
+func (response GetPodsLogs403JSONResponse) VisitGetPodsLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response GetLog404JSONResponse) VisitGetLogResponse(w http.ResponseWriter) error {
+type GetPodsLogs404JSONResponse Error
+
+func (response GetPodsLogs404JSONResponse) VisitGetPodsLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogPageRequestObject struct {
-	Params GetLogPageParams
+type GetPodsLogs500JSONResponse Error
+
+func (response GetPodsLogs500JSONResponse) VisitGetPodsLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogPageResponseObject interface {
-	VisitGetLogPageResponse(w http.ResponseWriter) error
+type GetRootsRequestObject struct {
 }
 
-type GetLogPage200JSONResponse struct {
-	Contents     openapi_types.File `json:"contents"`
-	NextPage     *int               `json:"next_page,omitempty"`
-	Page         int                `json:"page"`
-	Path         string             `json:"path"`
-	PreviousPage *int               `json:"previous_page,omitempty"`
+type GetRootsResponseObject interface {
+	VisitGetRootsResponse(w http.ResponseWriter) error
 }
 
-func (response GetLogPage200JSONResponse) VisitGetLogPageResponse(w http.ResponseWriter) error {
+type GetRoots200JSONResponse struct {
+	Roots []Root `json:"roots"`
+}
+
+func (response GetRoots200JSONResponse) VisitGetRootsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogPage400JSONResponse struct {
-	Message string `json:"message"`
+type GetViewsRequestObject struct {
+	Params GetViewsParams
 }
 
-func (response GetLogPage400JSONResponse) VisitGetLogPageResponse(w http.ResponseWriter) error {
+type GetViewsResponseObject interface {
+	VisitGetViewsResponse(w http.ResponseWriter) error
+}
+
+type GetViews200JSONResponse struct {
+	Views []View `json:"views"`
+}
+
+func (response GetViews200JSONResponse) VisitGetViewsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogPage404JSONResponse struct {
-	Message string `json:"message"`
-}
+type GetViews404JSONResponse Error
 
-func (response GetLogPage404JSONResponse) VisitGetLogPageResponse(w http.ResponseWriter) error {
+func (response GetViews404JSONResponse) VisitGetViewsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogRawRequestObject struct {
-	Params GetLogRawParams
+type PostViewsRequestObject struct {
+	Body *PostViewsJSONRequestBody
 }
 
-type GetLogRawResponseObject interface {
-	VisitGetLogRawResponse(w http.ResponseWriter) error
+type PostViewsResponseObject interface {
+	VisitPostViewsResponse(w http.ResponseWriter) error
 }
 
-type GetLogRaw200JSONResponse struct {
-	Contents openapi_types.File `json:"contents"`
-}
+type PostViews201JSONResponse View
 
-func (response GetLogRaw200JSONResponse) VisitGetLogRawResponse(w http.ResponseWriter) error {
+func (response PostViews201JSONResponse) VisitPostViewsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogRaw400JSONResponse struct {
-	Message string `json:"message"`
+type PostViews400JSONResponse Error
+
+func (response PostViews400JSONResponse) VisitPostViewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response GetLogRaw400JSONResponse) VisitGetLogRawResponse(w http.ResponseWriter) error {
+type PostViews403JSONResponse Error
+
+func (response PostViews403JSONResponse) VisitPostViewsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogRaw404JSONResponse struct {
-	Message string `json:"message"`
-}
+type PostViews500JSONResponse Error
 
-func (response GetLogRaw404JSONResponse) VisitGetLogRawResponse(w http.ResponseWriter) error {
+func (response PostViews500JSONResponse) VisitPostViewsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogsRequestObject struct {
-	Params GetLogsParams
+type DeleteViewsIdRequestObject struct {
+	Id string `json:"id"`
 }
 
-type GetLogsResponseObject interface {
-	VisitGetLogsResponse(w http.ResponseWriter) error
+type DeleteViewsIdResponseObject interface {
+	VisitDeleteViewsIdResponse(w http.ResponseWriter) error
 }
 
-type GetLogs200JSONResponse struct {
-	Logfiles []LogFile `json:"logfiles"`
+type DeleteViewsId204Response struct {
 }
 
-func (response GetLogs200JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (response DeleteViewsId204Response) VisitDeleteViewsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetLogs400JSONResponse struct {
-	Message string `json:"message"`
-}
+type DeleteViewsId403JSONResponse Error
 
-func (response GetLogs400JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+func (response DeleteViewsId403JSONResponse) VisitDeleteViewsIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogs404JSONResponse struct {
-	Message string `json:"message"`
-}
+type DeleteViewsId404JSONResponse Error
 
-func (response GetLogs404JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+func (response DeleteViewsId404JSONResponse) VisitDeleteViewsIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLogs500JSONResponse struct {
-	Message string `json:"message"`
-}
+type DeleteViewsId500JSONResponse Error
 
-func (response GetLogs500JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+func (response DeleteViewsId500JSONResponse) VisitDeleteViewsIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -1313,9 +5466,30 @@ func (response GetLogs500JSONResponse) VisitGetLogsResponse(w http.ResponseWrite
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Get the annotations of a log
+	// (GET /annotations)
+	GetAnnotations(ctx context.Context, request GetAnnotationsRequestObject) (GetAnnotationsResponseObject, error)
+	// Annotate a line of a log
+	// (POST /annotations)
+	PostAnnotations(ctx context.Context, request PostAnnotationsRequestObject) (PostAnnotationsResponseObject, error)
+	// Delete an annotation
+	// (DELETE /annotations/{id})
+	DeleteAnnotationsId(ctx context.Context, request DeleteAnnotationsIdRequestObject) (DeleteAnnotationsIdResponseObject, error)
+	// Update an annotation
+	// (PUT /annotations/{id})
+	PutAnnotationsId(ctx context.Context, request PutAnnotationsIdRequestObject) (PutAnnotationsIdResponseObject, error)
+	// Get recent audit records
+	// (GET /audit)
+	GetAudit(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error)
 	// Get log details
 	// (GET /log)
 	GetLog(ctx context.Context, request GetLogRequestObject) (GetLogResponseObject, error)
+	// Get the bytes of a log file
+	// (GET /log/bytes)
+	GetLogBytes(ctx context.Context, request GetLogBytesRequestObject) (GetLogBytesResponseObject, error)
+	// Export a log
+	// (GET /log/export)
+	GetLogExport(ctx context.Context, request GetLogExportRequestObject) (GetLogExportResponseObject, error)
 	// Get log page
 	// (GET /log/page)
 	GetLogPage(ctx context.Context, request GetLogPageRequestObject) (GetLogPageResponseObject, error)
@@ -1325,6 +5499,21 @@ type StrictServerInterface interface {
 	// Get a list of logs
 	// (GET /logs)
 	GetLogs(ctx context.Context, request GetLogsRequestObject) (GetLogsResponseObject, error)
+	// Get logs of pods matching a label selector
+	// (GET /pods/logs)
+	GetPodsLogs(ctx context.Context, request GetPodsLogsRequestObject) (GetPodsLogsResponseObject, error)
+	// Get a list of roots
+	// (GET /roots)
+	GetRoots(ctx context.Context, request GetRootsRequestObject) (GetRootsResponseObject, error)
+	// Get the saved views
+	// (GET /views)
+	GetViews(ctx context.Context, request GetViewsRequestObject) (GetViewsResponseObject, error)
+	// Save a view
+	// (POST /views)
+	PostViews(ctx context.Context, request PostViewsRequestObject) (PostViewsResponseObject, error)
+	// Delete a saved view
+	// (DELETE /views/{id})
+	DeleteViewsId(ctx context.Context, request DeleteViewsIdRequestObject) (DeleteViewsIdResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -1356,6 +5545,148 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// GetAnnotations operation middleware
+func (sh *strictHandler) GetAnnotations(w http.ResponseWriter, r *http.Request, params GetAnnotationsParams) {
+	var request GetAnnotationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnnotations(ctx, request.(GetAnnotationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnnotations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnnotationsResponseObject); ok {
+		if err := validResponse.VisitGetAnnotationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAnnotations operation middleware
+func (sh *strictHandler) PostAnnotations(w http.ResponseWriter, r *http.Request) {
+	var request PostAnnotationsRequestObject
+
+	var body PostAnnotationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAnnotations(ctx, request.(PostAnnotationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAnnotations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAnnotationsResponseObject); ok {
+		if err := validResponse.VisitPostAnnotationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAnnotationsId operation middleware
+func (sh *strictHandler) DeleteAnnotationsId(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteAnnotationsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAnnotationsId(ctx, request.(DeleteAnnotationsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAnnotationsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAnnotationsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteAnnotationsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAnnotationsId operation middleware
+func (sh *strictHandler) PutAnnotationsId(w http.ResponseWriter, r *http.Request, id string) {
+	var request PutAnnotationsIdRequestObject
+
+	request.Id = id
+
+	var body PutAnnotationsIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAnnotationsId(ctx, request.(PutAnnotationsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAnnotationsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAnnotationsIdResponseObject); ok {
+		if err := validResponse.VisitPutAnnotationsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAudit operation middleware
+func (sh *strictHandler) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	var request GetAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAudit(ctx, request.(GetAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAudit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditResponseObject); ok {
+		if err := validResponse.VisitGetAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetLog operation middleware
 func (sh *strictHandler) GetLog(w http.ResponseWriter, r *http.Request, params GetLogParams) {
 	var request GetLogRequestObject
@@ -1382,6 +5713,58 @@ func (sh *strictHandler) GetLog(w http.ResponseWriter, r *http.Request, params G
 	}
 }
 
+// GetLogBytes operation middleware
+func (sh *strictHandler) GetLogBytes(w http.ResponseWriter, r *http.Request, params GetLogBytesParams) {
+	var request GetLogBytesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLogBytes(ctx, request.(GetLogBytesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLogBytes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLogBytesResponseObject); ok {
+		if err := validResponse.VisitGetLogBytesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLogExport operation middleware
+func (sh *strictHandler) GetLogExport(w http.ResponseWriter, r *http.Request, params GetLogExportParams) {
+	var request GetLogExportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLogExport(ctx, request.(GetLogExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLogExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLogExportResponseObject); ok {
+		if err := validResponse.VisitGetLogExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetLogPage operation middleware
 func (sh *strictHandler) GetLogPage(w http.ResponseWriter, r *http.Request, params GetLogPageParams) {
 	var request GetLogPageRequestObject
@@ -1460,23 +5843,242 @@ func (sh *strictHandler) GetLogs(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
+// GetPodsLogs operation middleware
+func (sh *strictHandler) GetPodsLogs(w http.ResponseWriter, r *http.Request, params GetPodsLogsParams) {
+	var request GetPodsLogsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPodsLogs(ctx, request.(GetPodsLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPodsLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPodsLogsResponseObject); ok {
+		if err := validResponse.VisitGetPodsLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRoots operation middleware
+func (sh *strictHandler) GetRoots(w http.ResponseWriter, r *http.Request) {
+	var request GetRootsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRoots(ctx, request.(GetRootsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRoots")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRootsResponseObject); ok {
+		if err := validResponse.VisitGetRootsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetViews operation middleware
+func (sh *strictHandler) GetViews(w http.ResponseWriter, r *http.Request, params GetViewsParams) {
+	var request GetViewsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetViews(ctx, request.(GetViewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetViews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetViewsResponseObject); ok {
+		if err := validResponse.VisitGetViewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostViews operation middleware
+func (sh *strictHandler) PostViews(w http.ResponseWriter, r *http.Request) {
+	var request PostViewsRequestObject
+
+	var body PostViewsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostViews(ctx, request.(PostViewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostViews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostViewsResponseObject); ok {
+		if err := validResponse.VisitPostViewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteViewsId operation middleware
+func (sh *strictHandler) DeleteViewsId(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteViewsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteViewsId(ctx, request.(DeleteViewsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteViewsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteViewsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteViewsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xXXU/rRhD9K6ttH63EofTFb7e6vRUiahGt+kIR2thjZ5G9u8yOAynKf69mnThxHEhQ",
-	"CVwknmzHs3Pm45yZ+FGmtnLWgCEvk0fp0ylUKtyObfEVSOkyPDm0DpA0hKdcl3Dj9b/ADxn4FLUjbY1M",
-	"5F9TEPxG2FzQFERpC8HmQhsxmRP4gYwkPKjKlSCTUXxyGkmaO5CJ1IagAJSLSBpVBd+toSxtMRqUtpCt",
-	"uSfUpmBrp2jatZ4pHD59YhFJhLtaI2QyuWrAll6ijdyu24N2cgspMdTYFt90Cf2SZBo7IeSq9NA6mFhb",
-	"gjJvnhpHFXUS7CfFZ7TJLcOQpgByXk8ADRB4MbaF+FvDPaD4cnEmIzkD9E2v40E8GHGU1oFRTstE/jSI",
-	"B/ESK9SFY+VrAdQny29APrAka5jGpFEtZZgpXGLF1mdZYz8OWTuFqgIC9DK52kVBxhdkOxRkf5oN7mrA",
-	"+aouyarx67oR1hAttRCqslXjazb2zhrf9P4kjvmSWkNgQprKuVKnIfDhreeoHjf8/YiQy0T+MFyLb7hU",
-	"3nBDdqEz3dT+OOdyn74Qr8vUCrxXxRYHxyuZGkvCO0h1riHby6+Vr92s6sb+i8rEJdzV4KlJ4vSoSeS2",
-	"Nq+bwO+WxLfgld/5uqoUzhtWBo5lbd+iQPuhW0b4NPeVYBumva3J1SRytNUBErhgz+8tg2g3YgHC1NUE",
-	"kIERCDXMngENiaxBMshVXZJM4v5e+N/C63Joecz3prFYvfnH8EKwWCniIa6NCrH3prSBB7px23wc7dps",
-	"Pat4t9XLxn4kHcJM29rfHAKwJYJlE9p6PLsqPgfShxlIoa/tNEJ1v38Rrzhw2Ca+VPcffhkfZyZsdbV1",
-	"e0hbv6zjXaF+iuy7FNlaIa3M/H6NldoT64utn1KWf6muMo2QksU5/xAQ2L2oTQa4R2tvpa3SFlyqrrau",
-	"Nj9/SluchNvrSGqCyh/wbzl8ji3aRipENe81vIV+1532te3Rcah6fK1dMOOOrrNI/vz6xT8zBGhUKTzg",
-	"DFAAosVXzaBF+LNB+DUg7JwaGxOAPS3+CwAA//9VVSRMiBEAAA==",
+	"H4sIAAAAAAAC/+x9e2/cuLX4VyH0+y3aArI9dpxs6yK4yCZx4Vs3Gzi7W+CuC5uWzsxwLZFakvJ4Gvi7",
+	"X5xDUm/NyF7n0bv+Lx5J5OF58bzzMUpUXigJ0pro6GNUcM1zsKDpr2MBWXossvB3CibRorBCyego+l5m",
+	"a6bBlloyY3WZ2FJDyv743x++f/cnlgkJhgnJVkuRLJldApvjcoxbxlmqrIWUFdwu2ZIberwQNyDZDc9K",
+	"iJmau0+UztklvvaSHlzGDHYXu+wygxvIXoLWSl8ypdnl0tpi11huS/Py+Wx2uctOCYK8NJbl3CZLBjeg",
+	"12xO54kZl7j/AgxLVCktU3gc3JNeFnLhjrAbxRHcFplKITqyuoQ4Enj6X0vQ6yiOJM8hOorobFEcmWQJ",
+	"OUdkCQs5YQ1ueV5k+FID6CiO7LrAH43VQi6iu+oHrjVf49/GrukzRAL+fayyTK36hPgnFxYxxSSsPN6t",
+	"YlfAVlpYCxL/4iwTN8AytYhZKa3I6Kgafi3BICXcZ1wD4zdcZPwqA0QrZ1bkoErLIOOFAbPLiOymLAql",
+	"8cOrtVv5iifXIFPC1yCCHPBNDKUw52Vmo6M5zwxU579SKgMuo7u7ODpFjG3hPQd6phYLIO5SEgL7OJYi",
+	"tJsjZjVPIGYpXJWLmAk5VzFbcS1jRiTB88655dku+2EJ7itciDe5GzdjwjBhjX+DKO/YSdkl6JUw4Nld",
+	"G8tWSqdMAtf0m7Fc2wAdrSV5jrzG3Wqevc+jt2dn35+dRxO5j77dxn0P5rv3Gm6EKgfIcOYoQIdRi3Cu",
+	"wr/PhDSWywQcFjXQ6SFliZKWCwn6wdwUtrgvP30ArpPlFH7yMCJtak6ycGtjJhZSIe5Ywg2MQWjcTk34",
+	"alJ4oeoTA0EUMoEfRA5ToAwSjmyvGZ9b0E1wRQ6oBxsIdWrOWA0876xhcGP6Gr9zHB3Y1CAJib2R0E5R",
+	"OEXqiGaXIDR9ZyzPCxOz1RI0sYIBaUeRhFte4GctRCHjcRsdRSm3sOMfD2DKalG8evfhZIgxc3UDDB8y",
+	"MAkvgBnUdTIBEzNTJkvGkcaZKrWJ2VyrnM6KVAdpzS5745iJVCk+MaBvQP8BP5JzsSg1x61GD4agXXBp",
+	"ROtgAxz5AxcZ3VSbyV0TVZb5FWikhyNMBTvINEhgphYPFi3LRXZBSw/TREj74rCmh5AWFqDdWSr69w/z",
+	"XsNc3DLgydIpvpWwy4rbmLBsxStefDjwNQT31Aw/4qV4X7G7grnS0JG4mP1SpovfJBd0Qz9QLu7CF0SE",
+	"V1Iqy91Buud6xaSywJQk60B6NY28E8VRoVUB2grHmLy0S6X7S+BFWRrQbLVULNHAkViIDl7t666woPl4",
+	"JhIYuoT8x1OPGUcibevUZ/MD/pdkPz28eg4v5t/Ohr7BUw4fopaqBvT+vo+dhYgan4Rtv3Wk/YNZXxji",
+	"CG3WNoCFVuneDdd7mVrs72ZqMXgXw61tf3ZMZoSzUJB3hr4qi/Q+yLuLI7T8hMZPfkZMeng9hjwYcSB7",
+	"TZ16q39Vq6qrXyCxCEbNbD/SW+RRtPiof7w3UGRqzbxpEMVRLuQpyAVib38b5LTcICRlKuwZJEqnQ3xv",
+	"hFxkwHiSgCENj9aL1/195r9aWzDb2IZecrdE2uKPw9lfXgwxCMi0UEJ20PG3tz+wPV4IZJI9dE+GyL3Q",
+	"qiza1t3PkdGAqKgMv61m3gM51Kmq9nfon+HH3xwc33D9zcFxWOC8nM0OXuA5Xh4MLaa5XIxIJCKU0fPa",
+	"TYmZmDMu122dQqh/OdvZnx08G9rEeYUtkA9mg0Jrvf6fpoFQ8bURMaLdulzr1qPPG3wQe0arAB5i7Ldk",
+	"xPdZWnoN4a4pd/lwuWZh9T5XJ+RTfIz+v4Z5dBT9v706ErDnL5A92u01vngXRzkYw4eo9Yoty5zLHQ08",
+	"Jcex8ThoVIKuTTYksikgEXMRQgGpAsOksgxuhbFbEUlHqAEbxddrf9Qu2DlHRx9qwEUK0iI81U1wLZxV",
+	"RfDHPpihIedCGlRa9JV71YPBkiUyrQscyDInDStveCbSC8/IUVz94tWuVPZirkqJCnCu9JVIU5ARnpan",
+	"F3MuMlKNyKla8iz6Vw8zcfT2tlDaHnvObdg8QZn3RcyxOV37kgF9f8QKrg2kTJPyNIwbDCvgtbCTQiZy",
+	"gbcihnhiZiCDBP8kF5heff3hp5gp3fAbuGFFxoXznZo4kekvRuEZE3MTbpyhc52qxRtAo9T0r5MrEHJx",
+	"4a2xgcDIEshlCf6pQJKJLGP0XbDiYoSxNtmENcHM9IEAdHjUnOlSkjtYea/ODWqttcuOlUbPCEzM7FIY",
+	"3JOzJZRaGCsS/JFbHx7IgGzeXKVOBNAiFt6b5sayXMjSekfMcTQF1Di7KpNrsLS7xKBWB4amlI0YvHGE",
+	"218Y8e8R7YtPGt6Eg1ZId8217Z/ZweGQLqXwx4UV+cgO+CTsQO823AJem8xNlT9NLc8rEdik2k7VwssK",
+	"2ZIo93Y9qFhVwX8th3RDUmoN0lZOYxdfGGrS4gZSZzYiYxFakZ6O6AkZS95rcLrFaw/0E2TNJ6gYKaJ5",
+	"7cyV4JKTuc4SLlkhJBM2xqcpWEhszWnu7Zyv2ZLfAMtV10SJ9r+dH/BnV4fJ8/QFfDv/887hbNh+5pNJ",
+	"iq8+FkWzKgrI01Tghjx737Yre+y3yVbrRgydU+rib85pYxIBywRqQnoQwsl4GAyH+nidk0FlnYfQQetH",
+	"H3I72j9A3T1X0dH+/mx2N3BNIUQXtMY2O7OKqwc/v+OLDNo1uUq3ka0l6KiWnAryumk6rZwH24p6bzAm",
+	"H2SDdqwA2rFyYGq91jh2Q8JbuK4OVfFY3LlThmyKU7V4ewNDpHrF/l5egZZgwWC6QdqYFcoQyyKr5cqH",
+	"ExsCjMbxkHVWdlyDZ0OEHXdohUzhthVpblwvuGetHwhQphLSZ6kPauyyV9IvAr+WPAtxsC4nFhlPwDTW",
+	"qYOQlQro8Oggh9bGZfVm9B1PrnfUfB5Cx+SBkzFUX8JDXOVJ1VrrvUrRr9r5Nv1L8uLq+fxw5/bg+s+/",
+	"Dn2ugRsl258jKN/P50Ov389pcD80l/4n12hXTHUb6KUKyBpz1bHjKDA3cccIBx+LbMA/T0Xboxk1Hz6D",
+	"mMeRWeeZkNcXlusFjGhG96xyM6TVa7pghHWml1nnVyoTCTLidfvWC/vvFSo1e95evuCFuJjtHzwjL3w2",
+	"SQMh1uKWHhpDesM6HzHGSSpzZWxTR6BixpsmxB2FPCIbPJiFMb4xzy27hrVLlLKCC23IFp9rgJ717W1v",
+	"99km8/sdrNoxxDbDBAXUDoflQoq8zJsRnM8UGrtP9Ggg5DVEt3ew+knAqn925/Z0wjCdPPT9QjK1odNY",
+	"kc53v3X60vnq/YlDlNmGJEefLhBEIS8iQ3q0IS0bIc2FPHEP9/tgmyo7NyFVFjezR5PVbyMxM+ouDmaN",
+	"SP409I3nURVZZ43HtyIhrnPvZqlWMhh39ffsRsBq2qaNzMHDgsENLTYcgXqv0lF/vHaNO+zDC0qEiRQS",
+	"/pt5edI1PnysBoAjZztVi1Ov00bO1gNmMJ3hfcLeHcm8uqlIcyUk1+uhNQqVPuDgtUGyydIng7BOuNGV",
+	"eS3VSk619Lu6VKVN9EYNDAwh+kwp20dxn9SodqYbFPdyFYbACnq+ly/gN14KKQ8uqTYmV9oFh+I6l+ly",
+	"4oZc/FSYIuNrpmgdMpHXDSnHbx6YaHPg4H49xfCICbbHv94elLJ7pCsRzb+RJM6PZ6eGXAxX1RHwiqR2",
+	"kQHnZVd7bQorIQdhmOYBt/JnvYefrt6v4OqNo1JnI/HXpdIWOXOAMUOoEEH2lWhqQaZ+Jow1QcFfb2Rq",
+	"NQ9fmq5XtLddLocyyC3LoUWDuF0PM5RV1lkUZHRMMZNY9e6MEWdimoiMU6SBj0wt/qtRuRlSmnXK0+/1",
+	"zcHxwG7u128Ojqe5kt4nQSj6eLi7C3HEj5EVlsBrBJxO1YIholCO3p9EcXQD2jiWmu3OdvfxeKoAiSbL",
+	"UfRsd7Y7ixpaZq+u2aC/B13uv4FnsMbLTMluUIsYUrhyGaZ0CpT7Q7rRJyepW+pVY8e4VXz885BQIKgh",
+	"DhVilaMVgg6RNW5d9WSvFquiw7/wZVMoaRxjHcxmwezzsT5eFJkP2e/94iNE9Xqde7yNy0l3R42Nvobu",
+	"sElz+WE+6ZQw/R2XPLznkbamhoe2+o6n7MznOWnPZ59+z+MqZYrPTJnnaFITi/WZ1fOns7DNUBQ3xWSm",
+	"bHwVKqgbNVK+UtcqlnN97cu6eEjDu4RWFVJNS+2KfHHDnVxpC3lfIN4r05EIny/+TqXrR0NiO6Bzd3fX",
+	"FZK7niDsP9rm3Z3biH/t74PfCavG0fPPccoTXzXAPlAJKwsvNgXF0wW6XE6vNS+GvY8ivXMik4EdcDPf",
+	"0O8d+fH1nHaoWrD1ImUp3dJM2L6IuNUbQnKSRj12PexD9U6x1x7JX4TQh7PDT7/jO2XZMRWRfE2s5UjW",
+	"JnN0N+G2P3nTKcds1luHuhl32Yv0vld9UQ4ofle16OwbDAf7ypiH8rErlRzk4/el7TPx4+v6XkHmJHU/",
+	"+0zq/vdjlPyuFYBjva4CoHsFq3S3uxqUDdOQUHaZSnahW7QbY5EaGOsMLy+mKKKGLTSXthEu26FdWQE6",
+	"F8YEWcUn9Ip76kM+fYcFn05xVXJ+ixmwRro8FNRZxTRYLeBm1HehMrvhBob9WTO9NlDs8ch+jId6ug/T",
+	"KLze5sSEte/jwPxfl9ue+xL4ntgyYIykB82zrbKTulRNnUZ2hWlCJlmZok9iUCCNFYmpQkLU9kqlj42H",
+	"XAPDM5W+HUcDT0NgabVUmfPIqWrNOT+Nom2Mhic8WULa6D0l6XXFbi5NrXTVo0p9MspUtbQsIdWBPa2u",
+	"uA1XxQ/KwrlmrlRyUGRP1WKKwH7VsYUthYwhH/d0x35ZWUW+SStaOBHdq7pHBgWV7k3T6j1syyqDW57Y",
+	"bM24YcYqjZyvuS9r5pKBTFRaFw9jVHsXI4Em1JNybFMjcWXcMs1lqnKm5nMD1oQOu0azhYmZUa4KJVUr",
+	"mSmemnoRU+aVQCsZajGDrJu65e3tD3zhkmSdOtfdc3kuT+btE7sAf8pdNbmvH8frO66zZkIai4dwvauQ",
+	"MiHZvMwyOjkzkGg8UM7NNUIY6jQbB0NwqOieW6UNVX3j21TS7dUMbhtQ430PV/DMToNmIlgycQ3U590F",
+	"hk43nsTg2YqvDbuGwsZULhd6B1x3KaMwOYGRIyr9rU+gUv7DK9ycrVSZpTUYDv4N4P9ogF0SO2q+cgMT",
+	"6C9X9H9JOK+oEXCHO42p1O98q8qX1avx5sYhMrYItWFshGsVwpL1ncsKiiXwFHQNxhl+O9K5vbnXqA+Q",
+	"62NFEJjtwCbqanHXldAehDEqQUOzBqobePRQJ/Od/rm2gn9Gt5bTLgxV3z9Cs0IDeoS7N3QBwTeboHmn",
+	"JOz8g9tk+YlAcgYDyLrBou5wb/THbwAxrLxDPfnRb7vhMaCwR60wf0V51Qbsy9LOd/7cvpTaxSJBJs/l",
+	"lJKRu8G694H2BDy0O69rEk4SKKzjDzOeMW3p0hrX1eXg7b3dTaIzJDMR8kp/25PQcGG2dVvsbuag6JQb",
+	"WxFzY2VM6JhQi+Ea+PFdcJ+D2YuHEf2BlK1QPkKa3egujp4Nx0NruXkyED9dEOZw/8Uwvw2TLnSTNDvB",
+	"iIyDWS38smMt1manu9nH7U4yXMxA3raef9QoaHLeVjAIySDp9An+8d0bGvzUbwv84+sPP/2JKd3sA2Rn",
+	"QVlWjqUGY7wdyxb/FkVQ50kmQuCnsGYwmupMEtcA+XXaJKG4W/k2ywbehdxlJ1SsjpSXZE1euvcvq0oP",
+	"QqmjlgY/PIKH/tqCt8x2fMnvgpyuVeYMeLMkE5Rg6VesNRR66EgZQodb+KJqm5koLM3e1BEUNUaCmbov",
+	"kDiomnITHKWszEMm9/WHn/xp42brCY1Wothbf5SLM6J8/5ZMQ+NuQLYz1I1i1JDLhGSl7EyAmjoVzEG6",
+	"bTBTo6buAeOZhjFfy8BeNb5pwrv18KEJL9cjU6asXA3rmfBya/DclMVd9dqEN/1AsXtGZG53ZNq/HrqW",
+	"Pvh2DFaA9oNDSMxCUM0zZDzOgGHkkud7NWfDjBc45+M5dS+cYzX9OdXrnUdH59EB+jez/Z3ZwQ+zZ0ez",
+	"w6PZ8/85j+JzV0xJr7hSJvzNb06/uuYq+tmBcB4dfTz38wjOo6Pns9nd3URb1HVU7GF395iBiygiXBBc",
+	"cYBE7sdDJ4gJ5tjDeC8gvoTZ3R4jSFcml4xby5Nl7ueMNGxwn57eeSNM6BgcaQXlzb7pDKjj11/MTd3P",
+	"zZghXsPwV1oBV3x5jgyPJWq7ibk5j4ZO+WQnfo5A4ltPvrr8ohoFszHa7zpJkTNUaYvSuqZz3jJwhsym",
+	"96558Cs0mug8PoE2IWvmp+UMJM2G0mQDcQW3fN0gOzgCsKXIO3Oh4ipIqeb1MsEM+SfeBaRWa6s6jIgY",
+	"29OlUlqqpDfrL3TYDmcSZRsnmzrzplzMbornl7Q66oF5U16u58H959ko97LAPmn5al82N5X/ElNXTsRv",
+	"rXzd1Et1v3s6juAmrNM/kXtWSa5KfQrSD45xI1awTADS4Cl06zvrg7O3N1ViQ/nxgaRd0irUTn1SLqXg",
+	"pobRyKYweoYtVUb51UKljYmVCFqj4hv7R+hjTSlUZDCaN3IFRqTAOEvLvGgc6Q9+KoGZTJlq1sFwSw1c",
+	"OO22dTxaPfdRdXI/7VjH5ll7P+/HBwNtLc0JXh0YJdzai6I7WWCkLbnz1mPO9QsDay+m7RKSYePBWC7X",
+	"VdJLaVaANpSKS7nlbAUafCostLRUk00n9LL0ehIW0Gzo61A+rq97D/RTRfpXmJgmKlb2pOar7cUjwxnp",
+	"MUPyjK++BjvyyYJxFszjGgSPdwd/VaqtodPupb1e1agL2z+ps8+mzgbyHRM61jJhqKI7tDwO6bB7lzSk",
+	"QkNilV67sWyGdK1hpfQtbxu02udqRcMZLyKDbidxw1rJ1OKg1zK8bXgeYX9LhWe19ZNF8FQO3hbghjA6",
+	"IaYhTNsluQ5z2cZsTp5lzbmcYcyZSk0dPOEs41eQ+WSa0jHLQYc57eGWdSF4vvC/F8olOymOf3ldXkFi",
+	"M7frTsawFfhZEtajv4Dt7PAs26mBuRzUNe9VaqbqG62U7QaG8Ghj+gXfHw6EVf+Kp0XgcEFT8AQaPqQJ",
+	"3q8br+UmaRtY5CDDGC6hXRqPcpL0H/DI+gTMZNwsXTneFbDzaPc8wn+fR7u759HYmSpIfntYsc0FroPT",
+	"JkvHLXzBhTTj/2GF/2ojFM2BLcXLwQExX0fAE6s5nZvt2ldb/3kLAQMyBScBLvrp5+NekludlNoofdmu",
+	"Rw114e4/W6J7OvwHFe4/v7GKzYXPc23KNLvVo99m7d/LLv9kxvP0sFNVO9EUtPEw02NFcab3dzQmJI1M",
+	"OHFS2hKDUa3j4zOe1BuG77o3fLEJ4mCpVmzOdTWghSr+qNoPGTB24kMXPOEVt3EcHcbuDEPyqJEild4L",
+	"r1UVfx+vXzx61M89j45B0X600/YhTpUynaoz291D7pZrXgwN7UzIj4OKDP+dzVOE6ske1cPxMVLBmw1G",
+	"+nAP+e4e3qYr6KKPXHlW1UTgo/54Pbpuq5DZ5/R6SAu6FGDbwKqrpNDwpRV9GH2kPO6MgH7czsCAh0n6",
+	"DQHY3hBIS06VzQ1ehVuIyIUZmwnkqie8mW4vKY7UMcFXqUvsqAEcW0elsvQhEVXlwvoa6B4RaKFtBj81",
+	"DCx8hSctW5XqORPs5M2YxSTSzxhVqPA6eSzaVvK7Je+jmr9wHKrDOOMTZT7w0OS1aUAgif74kMFQ3yls",
+	"ox+LOsJCqUc1NGx4tkzgv080VcbR+PPOk6n3fJok83Vcp8jqno0bCnj62JiGQA1N23BPvZhMGhZDXP80",
+	"JuY/ZUxMg/73nBITJjU+wnyYu7u7/x0Ag3zVahp7AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
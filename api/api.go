@@ -2,12 +2,20 @@
 package api
 
 import (
+	"context"
+	"log"
 	"net/http"
+	"os"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
+	"github.com/crystalix007/log-viewer/audit"
+	"github.com/crystalix007/log-viewer/authz"
+	"github.com/crystalix007/log-viewer/backend"
 	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+	"github.com/crystalix007/log-viewer/redact"
+	"github.com/crystalix007/log-viewer/store"
 )
 
 //go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen --config=oapi-codegen.yaml api.yaml
@@ -16,6 +24,36 @@ import (
 type API struct {
 	router           http.Handler
 	workingDirectory string
+	backend          backend.Backend
+	roots            []root
+	authenticators   []kmiddleware.Authenticator
+	policy           *authz.Policy
+	redactor         *redact.Redactor
+	audit            *audit.Log
+	stripANSI        bool
+
+	// stateDirectory holds the state of the viewer, such as annotations and
+	// saved views.
+	stateDirectory string
+	annotations    *store.Collection[Annotation]
+	views          *store.Collection[View]
+
+	// stats caches the statistics of the logs that have been viewed.
+	stats statsCache
+
+	// templateDirectory, if set, holds templates overriding the embedded
+	// templates of the same name, and reloadTemplates whether templates are
+	// reloaded when changed.
+	templateDirectory string
+	reloadTemplates   bool
+
+	// templates renders pages served outside of the render middleware, such
+	// as errors restoring saved views.
+	templates kmiddleware.TemplateProvider
+
+	// stopTemplates stops watching the template directory for changes, if
+	// templates are reloaded.
+	stopTemplates context.CancelFunc
 }
 
 // Ensure that API implements the StrictServerInterface.
@@ -30,35 +68,90 @@ func New(opts ...Option) (*API, error) {
 		opt(&a)
 	}
 
+	templateProvider, err := a.templateProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	a.templates = templateProvider
+
+	strictServer := NewStrictHandlerWithOptions(&a, []StrictMiddlewareFunc{withHTTPRequest}, StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  requestError,
+		ResponseErrorHandlerFunc: responseError,
+	})
+
+	// The API endpoints are routed separately from the middleware serving
+	// them, so that pages can dispatch their API requests in-process without
+	// being logged, authenticated or audited a second time.
+	apiRouter := chi.NewRouter()
+	apiRouter.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, ErrorCodeNotFound, "Not Found")
+	})
+
+	apiHandler := HandlerWithOptions(strictServer, ChiServerOptions{
+		BaseURL:          "/api",
+		BaseRouter:       apiRouter,
+		ErrorHandlerFunc: requestError,
+	})
+
 	mux := chi.NewRouter()
-	mux.Use(middleware.Logger)
+
+	// Requests are logged to standard error, as standard output may hold the
+	// audit log.
+	mux.Use(middleware.RequestLogger(&middleware.DefaultLogFormatter{
+		Logger: log.New(os.Stderr, "", log.LstdFlags),
+	}))
 	mux.Use(middleware.RedirectSlashes)
 	mux.Use(kmiddleware.AbsoluteURL)
 	mux.Use(middleware.SetHeader("X-Content-Type-Options", "nosniff"))
 
+	if len(a.authenticators) > 0 {
+		mux.Use(kmiddleware.Authentication(a.authenticators...))
+	}
+
+	mux.Use(kmiddleware.Compress(compressedPaths...))
+
+	if a.audit != nil {
+		mux.Use(kmiddleware.Audit(a.audit, auditedPaths...))
+	}
+
 	mux.Get("/api/openapi.json", a.GetOpenAPISpec)
 	mux.Get("/api", a.RenderDocs)
+	mux.Get("/v/{id}", a.RestoreView)
+	mux.Handle("/api/*", apiHandler)
 
 	// Apply the render middleware to all other routes.
 	mux.Group(func(r chi.Router) {
-		r.Use(kmiddleware.RenderMiddleware(templates, "templates"))
+		r.Use(kmiddleware.RenderMiddleware(templateProvider, apiHandler))
 
-		// If the request is not handled by the Render middleware, return a 404.
+		// If the request is not handled by the Render middleware, it is for an
+		// unknown API endpoint, so return a 404.
 		r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			http.Error(w, "Not Found", http.StatusNotFound)
+			writeError(w, http.StatusNotFound, ErrorCodeNotFound, "Not Found")
 		}))
 	})
 
-	strictServer := NewStrictHandler(&a, nil)
-	a.router = HandlerFromMuxWithBaseURL(strictServer, mux, "/api")
+	a.router = mux
 
 	if err := a.setDefaults(); err != nil {
+		a.Close()
+
 		return nil, err
 	}
 
 	return &a, nil
 }
 
+// Close releases the resources of the API, stopping watching the template
+// directory for changes.
+func (a *API) Close() error {
+	if a.stopTemplates != nil {
+		a.stopTemplates()
+	}
+
+	return nil
+}
+
 // ServeHTTP responds to HTTP requests, implementing the [http.Handler]
 // interface.
 func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/crystalix007/log-viewer/audit"
+	"github.com/crystalix007/log-viewer/backend/filesystem"
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+)
+
+// newTestAPI returns an API with the given options, which stores its state in
+// a temporary directory.
+func newTestAPI(t *testing.T, opts ...Option) *API {
+	t.Helper()
+
+	a, err := New(append([]Option{WithStateDirectory(t.TempDir())}, opts...)...)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	t.Cleanup(func() { a.Close() })
+
+	return a
+}
+
+// newFileAPI returns an API serving the given logs, by their slash-separated
+// paths, from a temporary directory as the "prod" root, and the directory.
+func newFileAPI(t *testing.T, logs map[string]string, opts ...Option) (*API, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for logPath, contents := range logs {
+		name := filepath.Join(dir, filepath.FromSlash(logPath))
+
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(name, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return newTestAPI(t, append([]Option{WithRoot("prod", filesystem.New(dir))}, opts...)...), dir
+}
+
+func TestRenderedPageDispatch(t *testing.T) {
+	auditLog := audit.New(io.Discard, 10)
+
+	a, _ := newFileAPI(t, map[string]string{"app.log": "line 1\nline 2\n"},
+		WithAuthentication(kmiddleware.NewTokenAuthenticator(map[string]kmiddleware.User{
+			"token": {Name: "alice"},
+		})),
+		WithAuditLog(auditLog),
+	)
+
+	request := httptest.NewRequest(http.MethodGet, "/log?path=prod%2Fapp.log", nil)
+	request.Header.Set("Authorization", "Bearer token")
+	request.Header.Set("Accept", "text/html")
+	request.Header.Set("Accept-Encoding", "gzip")
+
+	recorder := httptest.NewRecorder()
+	a.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", recorder.Code, recorder.Body)
+	}
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "text/html" {
+		t.Errorf("Content-Type = %q, want the rendered page", contentType)
+	}
+
+	// The page's API request is dispatched in-process without passing through
+	// the middleware again, so the view is audited once, as the page.
+	records := auditLog.Recent(10)
+	if len(records) != 1 {
+		t.Fatalf("audited %+v, want a single record", records)
+	}
+
+	if records[0].Endpoint != "GET /log" || records[0].User != "alice" || records[0].Path != "prod/app.log" {
+		t.Errorf("audited %+v, want alice viewing the page", records[0])
+	}
+}
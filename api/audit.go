@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+
+	"github.com/crystalix007/log-viewer/authz"
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+)
+
+// auditedPaths are the paths of the endpoints which read log content, whose
+// every request is recorded in the audit log. Pages rendering log content are
+// audited as well, as their API requests are dispatched in-process, bypassing
+// the audit middleware.
+var auditedPaths = []string{
+	"/api/log",
+	"/api/log/bytes",
+	"/api/log/export",
+	"/api/log/page",
+	"/api/log/raw",
+	"/api/pods/logs",
+	"/log",
+	"/log/page",
+	"/log/raw",
+	"/pods/logs",
+}
+
+// defaultAuditLimit is the number of audit records retrieved if no limit is
+// given.
+const defaultAuditLimit = 100
+
+// GetAudit retrieves the most recent audit records.
+func (a *API) GetAudit(
+	ctx context.Context,
+	request GetAuditRequestObject,
+) (GetAuditResponseObject, error) {
+	if a.audit == nil || !a.audit.Retains() {
+		return GetAudit404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The audit log is not available",
+		}, nil
+	}
+
+	user, _ := kmiddleware.UserFromContext(ctx)
+
+	if a.policy == nil || !a.policy.Permitted(user, authz.ViewAudit) {
+		return GetAudit403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: "Access to the audit log is denied",
+		}, nil
+	}
+
+	limit := defaultAuditLimit
+
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	records := a.audit.Recent(limit)
+
+	response := GetAudit200JSONResponse{
+		Records: make([]AuditRecord, len(records)),
+	}
+
+	for i, record := range records {
+		response.Records[i] = AuditRecord{
+			Time:     record.Time,
+			User:     record.User,
+			Endpoint: record.Endpoint,
+			Bytes:    int(record.Bytes),
+			Status:   record.Status,
+		}
+
+		if len(record.Groups) > 0 {
+			response.Records[i].Groups = &record.Groups
+		}
+
+		if record.Path != "" {
+			response.Records[i].Path = &record.Path
+		}
+
+		if record.Query != "" {
+			response.Records[i].Query = &record.Query
+		}
+
+		if record.Range != "" {
+			response.Records[i].Range = &record.Range
+		}
+	}
+
+	return response, nil
+}
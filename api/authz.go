@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/crystalix007/log-viewer/backend"
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+)
+
+// accessDenied is the message returned when the user may not view a path.
+const accessDenied = "Access to the specified path is denied"
+
+// allowed reports whether the user making the request may view the log or
+// directory at the given path.
+//
+// The path the backend resolves it to is checked too, so that a symbolic link
+// cannot be used to view a log that is denied at its own path.
+func (a *API) allowed(ctx context.Context, logPath string) bool {
+	if a.policy == nil {
+		return true
+	}
+
+	user, _ := kmiddleware.UserFromContext(ctx)
+
+	if !a.policy.Allowed(user, logPath) {
+		return false
+	}
+
+	resolved, ok := a.resolvedPath(ctx, logPath)
+
+	return !ok || a.policy.Allowed(user, resolved)
+}
+
+// browsable reports whether the user making the request may see the directory
+// at the given path, because they may view it or something beneath it.
+//
+// As with [API.allowed], the path the backend resolves it to is checked too.
+func (a *API) browsable(ctx context.Context, directory string) bool {
+	if a.policy == nil {
+		return true
+	}
+
+	user, _ := kmiddleware.UserFromContext(ctx)
+
+	if !a.policy.Browsable(user, directory) {
+		return false
+	}
+
+	resolved, ok := a.resolvedPath(ctx, directory)
+
+	return !ok || a.policy.Browsable(user, resolved)
+}
+
+// resolvedPath returns the path that the backend resolves the given path to,
+// if it differs, e.g. because it passes through a symbolic link.
+func (a *API) resolvedPath(ctx context.Context, requestPath string) (string, bool) {
+	b, rootPath, err := a.resolvePath(requestPath)
+	if err != nil {
+		return "", false
+	}
+
+	resolver, ok := b.(backend.Resolver)
+	if !ok {
+		return "", false
+	}
+
+	resolved, err := resolver.Resolve(ctx, rootPath)
+	if err != nil || resolved == rootPath {
+		return "", false
+	}
+
+	rootName, _, _ := strings.Cut(cleanPath(requestPath), "/")
+
+	return path.Join(rootName, resolved), true
+}
+
+// visible reports whether a directory entry should be listed to the user
+// making the request.
+func (a *API) visible(ctx context.Context, entryPath string, dir bool) bool {
+	if dir {
+		return a.browsable(ctx, entryPath)
+	}
+
+	return a.allowed(ctx, entryPath)
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/crystalix007/log-viewer/authz"
+	"github.com/crystalix007/log-viewer/backend/filesystem"
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+)
+
+func TestAllowedSymlink(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, dir := range []string{filepath.Join(dir, "public"), filepath.Join(dir, "secret")} {
+		if err := os.Mkdir(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "secret", "app.log"), []byte("secret\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for link, target := range map[string]string{
+		"public/app.log": "../secret/app.log",
+		"public/secret":  "../secret",
+	} {
+		if err := os.Symlink(target, filepath.Join(dir, link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a := newTestAPI(t,
+		WithRoot("prod", filesystem.New(dir)),
+		WithAuthorization(&authz.Policy{
+			Default: authz.Allow,
+			Rules: []authz.Rule{
+				{Effect: authz.Deny, Users: []string{"*"}, Paths: []string{"prod/secret/**"}},
+			},
+		}),
+	)
+
+	ctx := kmiddleware.ContextWithUser(context.Background(), kmiddleware.User{Name: "alice"})
+
+	for _, logPath := range []string{"prod/secret/app.log", "prod/public/app.log", "prod/public/secret/app.log"} {
+		if a.allowed(ctx, logPath) {
+			t.Errorf("%s is allowed, want it denied by the rule on its target", logPath)
+		}
+	}
+
+	for _, directory := range []string{"prod/secret", "prod/public/secret"} {
+		if a.browsable(ctx, directory) {
+			t.Errorf("%s is browsable, want it denied by the rule on its target", directory)
+		}
+	}
+
+	if !a.browsable(ctx, "prod/public") {
+		t.Error("prod/public is not browsable")
+	}
+}
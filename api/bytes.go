@@ -0,0 +1,159 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/crystalix007/log-viewer/backend"
+)
+
+func (a *API) GetLogBytes(
+	ctx context.Context,
+	request GetLogBytesRequestObject,
+) (GetLogBytesResponseObject, error) {
+	if request.Params.Path == "" {
+		return GetLogBytes400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: "Requires a non-empty log path",
+		}, nil
+	}
+
+	if !a.allowed(ctx, request.Params.Path) {
+		return GetLogBytes403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: accessDenied,
+		}, nil
+	}
+
+	b, rootPath, err := a.resolvePath(request.Params.Path)
+	if err != nil {
+		return GetLogBytes404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified path does not exist",
+		}, nil
+	}
+
+	info, err := b.Stat(ctx, rootPath)
+	if errors.Is(err, backend.ErrNotExist) {
+		return GetLogBytes404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified path does not exist",
+		}, nil
+	} else if err != nil {
+		return GetLogBytes400JSONResponse{
+			Code:    ErrorCodeInvalidPath,
+			Message: "Invalid path",
+		}, nil
+	}
+
+	file, err := b.Open(ctx, rootPath)
+	if errors.Is(err, backend.ErrNotExist) {
+		return GetLogBytes404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified path does not exist",
+		}, nil
+	} else if errors.Is(err, backend.ErrUnsafePath) {
+		return GetLogBytes400JSONResponse{
+			Code:    ErrorCodeInvalidPath,
+			Message: "Invalid path",
+		}, nil
+	} else if err != nil {
+		return GetLogBytes400JSONResponse{
+			Code:    ErrorCodeReadFailed,
+			Message: "Failed to open file",
+		}, nil
+	}
+
+	response := logBytesResponse{
+		file:     file,
+		contents: file,
+		info:     info,
+		request:  httpRequestFromContext(ctx),
+	}
+
+	// Masking secrets changes the offsets of the bytes, so redacted contents
+	// are streamed in full, line by line.
+	if redactor := a.redactorFor(ctx); redactor != nil {
+		exporter := &logExporter{
+			file:     file,
+			reader:   bufio.NewReader(file),
+			redactor: redactor,
+		}
+		exporter.encode = exporter.encodeText
+
+		response.contents = exporter
+	}
+
+	return response, nil
+}
+
+// httpRequestContextKey is the context key under which the HTTP request being
+// handled is stored by [withHTTPRequest].
+type httpRequestContextKey struct{}
+
+// withHTTPRequest stores the HTTP request in the context passed to the strict
+// handlers, for responses which must inspect more of the request than its
+// parameters.
+func withHTTPRequest(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+		return f(context.WithValue(ctx, httpRequestContextKey{}, r), w, r, request)
+	}
+}
+
+// httpRequestFromContext returns the HTTP request stored by [withHTTPRequest].
+// Handlers called directly, rather than through the router, are given a GET
+// request without headers.
+func httpRequestFromContext(ctx context.Context) *http.Request {
+	if r, ok := ctx.Value(httpRequestContextKey{}).(*http.Request); ok {
+		return r
+	}
+
+	return (&http.Request{Method: http.MethodGet, Header: http.Header{}}).WithContext(ctx)
+}
+
+// logBytesResponse serves the contents of a log. Contents which can be read
+// at random offsets are served by [http.ServeContent], which handles byte
+// ranges and conditional requests, and others are streamed in full.
+type logBytesResponse struct {
+	file     io.Closer
+	contents io.Reader
+	info     backend.Info
+
+	// request is the request being served, whose method, range and
+	// conditional headers are handled by [http.ServeContent].
+	request *http.Request
+}
+
+// VisitGetLogBytesResponse writes the response, implementing the
+// [GetLogBytesResponseObject] interface.
+func (response logBytesResponse) VisitGetLogBytesResponse(w http.ResponseWriter) error {
+	defer response.file.Close()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	seeker, ok := response.contents.(io.ReadSeeker)
+	if !ok {
+		w.Header().Set("Accept-Ranges", "none")
+		w.WriteHeader(http.StatusOK)
+
+		_, err := io.Copy(w, response.contents)
+
+		return err
+	}
+
+	w.Header().Set("ETag", strconv.Quote(fileIdentity(response.info)))
+
+	http.ServeContent(
+		w,
+		response.request,
+		response.info.Name,
+		response.info.ModTime,
+		seeker,
+	)
+
+	return nil
+}
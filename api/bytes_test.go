@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetLogBytesConditional(t *testing.T) {
+	a, _ := newFileAPI(t, map[string]string{"app.log": "\x1b[31mred\x1b[0m\nsecond\n"}, WithANSIStripping())
+
+	get := func(headers map[string]string) *httptest.ResponseRecorder {
+		t.Helper()
+
+		request := httptest.NewRequest(http.MethodGet, "/api/log/bytes?path=prod/app.log", nil)
+		for name, value := range headers {
+			request.Header.Set(name, value)
+		}
+
+		recorder := httptest.NewRecorder()
+		a.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	full := get(nil)
+	if full.Code != http.StatusOK || full.Body.String() != "\x1b[31mred\x1b[0m\nsecond\n" {
+		t.Fatalf("GET = %d %q, want the bytes as stored", full.Code, full.Body)
+	}
+
+	etag := full.Header().Get("ETag")
+
+	for name, test := range map[string]struct {
+		headers map[string]string
+		status  int
+		body    string
+	}{
+		"range":                  {map[string]string{"Range": "bytes=13-"}, http.StatusPartialContent, "second\n"},
+		"matching etag":          {map[string]string{"If-Match": etag, "Range": "bytes=13-"}, http.StatusPartialContent, "second\n"},
+		"changed etag":           {map[string]string{"If-Match": `"changed"`}, http.StatusPreconditionFailed, ""},
+		"unmodified since":       {map[string]string{"If-Unmodified-Since": time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)}, http.StatusOK, full.Body.String()},
+		"modified since":         {map[string]string{"If-Unmodified-Since": time.Unix(0, 0).UTC().Format(http.TimeFormat)}, http.StatusPreconditionFailed, ""},
+		"not modified by etag":   {map[string]string{"If-None-Match": etag}, http.StatusNotModified, ""},
+		"modified range by etag": {map[string]string{"If-Range": `"changed"`, "Range": "bytes=13-"}, http.StatusOK, full.Body.String()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			recorder := get(test.headers)
+
+			if recorder.Code != test.status || recorder.Body.String() != test.body {
+				t.Errorf("GET = %d %q, want %d %q", recorder.Code, recorder.Body, test.status, test.body)
+			}
+		})
+	}
+}
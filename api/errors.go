@@ -0,0 +1,35 @@
+package api
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+// writeError responds with an error in the format of the API's error schema.
+func writeError(w http.ResponseWriter, status int, code ErrorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	json.NewEncoder(w).Encode(Error{
+		Code:    code,
+		Message: message,
+	})
+}
+
+// requestError responds to requests whose parameters are invalid, e.g. missing
+// a required query parameter.
+func requestError(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, http.StatusBadRequest, ErrorCodeInvalidRequest, err.Error())
+}
+
+// responseError responds to requests whose handler failed unexpectedly.
+func responseError(w http.ResponseWriter, r *http.Request, err error) {
+	slog.ErrorContext(
+		r.Context(),
+		"failed to handle API request",
+		slog.Any("error", err),
+	)
+
+	writeError(w, http.StatusInternalServerError, ErrorCodeInternal, "Internal server error")
+}
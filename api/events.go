@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/crystalix007/log-viewer/backend"
+	"github.com/crystalix007/log-viewer/redact"
+)
+
+// logEvents returns the events of the pod whose container log is at the given
+// path, if the log is that of a pod's container.
+//
+// Backends serving pods directly hold container logs at a known path,
+// "<namespace>/<pod>/<container>". Archived pods are directories holding the
+// logs of their containers beside a dump of their events, so any other log is
+// read without looking for events.
+func (a *API) logEvents(ctx context.Context, logPath string) []backend.Event {
+	b, rootPath, err := a.resolvePath(logPath)
+	if err != nil {
+		return nil
+	}
+
+	if _, ok := b.(backend.EventSource); ok {
+		if segments := strings.Split(strings.Trim(rootPath, "/"), "/"); len(segments) != 3 {
+			return nil
+		}
+
+		return a.podEvents(ctx, path.Dir(logPath))
+	}
+
+	if backend.IsMetadataFile(path.Base(rootPath)) {
+		return nil
+	}
+
+	if _, err := b.Stat(ctx, path.Join(path.Dir(rootPath), backend.PodEventsFile)); err != nil {
+		return nil
+	}
+
+	return a.podEvents(ctx, path.Dir(logPath))
+}
+
+// podEvents returns the events of the pod at the given path.
+//
+// Events are supplementary to the log being viewed, so failing to retrieve
+// them is logged rather than failing the request.
+func (a *API) podEvents(ctx context.Context, podPath string) []backend.Event {
+	b, rootPath, err := a.resolvePath(podPath)
+	if err != nil {
+		return nil
+	}
+
+	events, err := backend.PodEvents(ctx, b, rootPath)
+	if errors.Is(err, backend.ErrNotExist) || errors.Is(err, backend.ErrUnsafePath) {
+		return nil
+	} else if err != nil {
+		slog.WarnContext(
+			ctx,
+			"failed to get pod events",
+			slog.String("pod", podPath),
+			slog.Any("error", err),
+		)
+
+		return nil
+	}
+
+	return events
+}
+
+// placeEvents positions the events which occurred during a page among its
+// lines, given the time that each line was written. The reason and message of
+// each event are redacted with the redactor, if any, as its lines are.
+//
+// A page owns the events from the time of its first line up to the time of
+// the first line of the next page, so that every event is shown exactly once.
+// The first page additionally owns all earlier events, and the last page all
+// later events.
+func placeEvents(
+	events []backend.Event,
+	redactor *redact.Redactor,
+	lineTimes []time.Time,
+	first bool,
+	nextTime *time.Time,
+) []LogEvent {
+	placed := []LogEvent{}
+
+	// Pages beyond the end of the log own no events.
+	if !first && len(lineTimes) == 0 {
+		return placed
+	}
+
+	for _, event := range events {
+		if !first && len(lineTimes) > 0 && event.Time.Before(lineTimes[0]) {
+			continue
+		}
+
+		if nextTime != nil && !event.Time.Before(*nextTime) {
+			continue
+		}
+
+		// Events are placed after any lines written at the same time.
+		line := sort.Search(len(lineTimes), func(i int) bool {
+			return lineTimes[i].After(event.Time)
+		})
+
+		placed = append(placed, LogEvent{
+			Time:    event.Time,
+			Type:    event.Type,
+			Reason:  redactText(redactor, event.Reason),
+			Message: redactText(redactor, event.Message),
+			Object:  event.Object,
+			Count:   event.Count,
+			Line:    line,
+		})
+	}
+
+	return placed
+}
+
+// redactText masks the sensitive content of text with the redactor, if any.
+func redactText(redactor *redact.Redactor, text string) string {
+	if redactor == nil {
+		return text
+	}
+
+	redacted, _ := redactor.Redact([]byte(text))
+
+	return string(redacted)
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crystalix007/log-viewer/backend"
+	"github.com/crystalix007/log-viewer/redact"
+)
+
+// eventSource is a live backend holding pods, whose events are recorded.
+type eventSource struct {
+	liveBackend
+
+	podPaths []string
+}
+
+func (e *eventSource) PodEvents(ctx context.Context, podPath string) ([]backend.Event, error) {
+	e.podPaths = append(e.podPaths, podPath)
+
+	return []backend.Event{{Reason: "Killing", Message: "Stopping container"}}, nil
+}
+
+// archivedEvents is the events dump of an archived pod, as output by `kubectl
+// get events -o json`.
+const archivedEvents = `{"items": [{
+	"type": "Warning",
+	"reason": "BackOff",
+	"message": "Back-off restarting failed container",
+	"lastTimestamp": "2024-01-02T03:04:06Z",
+	"involvedObject": {"kind": "Pod", "name": "pod"}
+}]}`
+
+func TestLogEvents(t *testing.T) {
+	source := &eventSource{}
+
+	a, _ := newFileAPI(t, map[string]string{
+		"ns/pod/" + backend.PodMetadataFile: `{"metadata": {"name": "pod"}}`,
+		"ns/pod/" + backend.PodEventsFile:   archivedEvents,
+		"ns/pod/main":                       "2024-01-02T03:04:05Z started\n2024-01-02T03:04:07Z crashed\n",
+		"app/app.log":                       "line\n",
+	}, WithRoot("live", source))
+
+	ctx := context.Background()
+
+	if events := a.logEvents(ctx, "live/ns/pod/main"); len(events) != 1 {
+		t.Errorf("the live container log has events %v, want the pod's event", events)
+	}
+
+	// The log of an archived pod's container has the events of the dump
+	// beside it.
+	if events := a.logEvents(ctx, "prod/ns/pod/main"); len(events) != 1 || events[0].Reason != "BackOff" {
+		t.Errorf("the archived container log has events %v, want the dumped event", events)
+	}
+
+	for _, logPath := range []string{
+		"live/app/main",
+		"live/a/b/c/main",
+		"prod/app/app.log",
+		"prod/ns/pod/" + backend.PodEventsFile,
+		"prod/ns/pod/" + backend.PodMetadataFile,
+	} {
+		if events := a.logEvents(ctx, logPath); events != nil {
+			t.Errorf("%s has events %v, want none", logPath, events)
+		}
+	}
+
+	if want := []string{"/ns/pod"}; !slices.Equal(source.podPaths, want) {
+		t.Errorf("events were retrieved for %v, want %v", source.podPaths, want)
+	}
+
+	// The dumped events are interleaved with the lines of the page.
+	response, err := a.GetLogPage(ctx, GetLogPageRequestObject{
+		Params: GetLogPageParams{Path: "prod/ns/pod/main"},
+	})
+	if err != nil {
+		t.Fatalf("GetLogPage: %v", err)
+	}
+
+	page, ok := response.(GetLogPage200JSONResponse)
+	if !ok {
+		t.Fatalf("GetLogPage returned %#v", response)
+	}
+
+	if page.Events == nil || len(*page.Events) != 1 || (*page.Events)[0].Line != 1 {
+		t.Errorf("the page has events %+v, want the dumped event before the second line", page.Events)
+	}
+}
+
+func TestPlaceEventsRedacted(t *testing.T) {
+	detector, err := redact.PatternDetector(`secret-\w+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eventTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	events := []backend.Event{{
+		Time:    eventTime,
+		Reason:  "Failed secret-reason",
+		Message: "pulling with token secret-token",
+	}}
+
+	placed := placeEvents(events, redact.New(detector), []time.Time{eventTime}, true, nil)
+	if len(placed) != 1 {
+		t.Fatalf("placed %v, want a single event", placed)
+	}
+
+	for field, value := range map[string]string{
+		"reason":  placed[0].Reason,
+		"message": placed[0].Message,
+	} {
+		if strings.Contains(value, "secret-") {
+			t.Errorf("the event %s %q was not redacted", field, value)
+		}
+	}
+
+	if placed := placeEvents(events, nil, []time.Time{eventTime}, true, nil); placed[0].Message != events[0].Message {
+		t.Errorf("message = %q, want it unredacted without a redactor", placed[0].Message)
+	}
+}
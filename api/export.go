@@ -0,0 +1,336 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/crystalix007/log-viewer/ansi"
+	"github.com/crystalix007/log-viewer/backend"
+	"github.com/crystalix007/log-viewer/redact"
+	"github.com/crystalix007/log-viewer/structured"
+)
+
+// compressedPaths are the paths of the endpoints whose responses are
+// compressed, if the client accepts it.
+var compressedPaths = []string{
+	"/api/log/export",
+}
+
+// exportColumns are the columns of a CSV export if none are requested, which
+// are found in unstructured lines as well as structured ones.
+var exportColumns = []string{"time", "level", "message"}
+
+func (a *API) GetLogExport(
+	ctx context.Context,
+	request GetLogExportRequestObject,
+) (GetLogExportResponseObject, error) {
+	if request.Params.Path == "" {
+		return GetLogExport400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: "Requires a non-empty log path",
+		}, nil
+	}
+
+	if !a.allowed(ctx, request.Params.Path) {
+		return GetLogExport403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: accessDenied,
+		}, nil
+	}
+
+	format := ExportFormatText
+
+	if request.Params.ExportFormat != nil {
+		format = *request.Params.ExportFormat
+	}
+
+	if format != ExportFormatNdjson && format != ExportFormatCsv && format != ExportFormatText {
+		return GetLogExport400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: fmt.Sprintf("Unknown export format %q", format),
+		}, nil
+	}
+
+	filter, err := newLineFilter(
+		request.Params.Field,
+		request.Params.Search,
+		request.Params.Level,
+		request.Params.SinceTime,
+		request.Params.UntilTime,
+	)
+	if err != nil {
+		return GetLogExport400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: err.Error(),
+		}, nil
+	}
+
+	redactor := a.redactorFor(ctx)
+
+	file, err := a.open(ctx, request.Params.Path, streamOptions(
+		nil,
+		request.Params.Previous,
+		request.Params.SinceTime,
+		nil,
+		nil,
+	))
+	if errors.Is(err, backend.ErrNotExist) {
+		return GetLogExport404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified path does not exist",
+		}, nil
+	} else if errors.Is(err, backend.ErrUnsafePath) {
+		return GetLogExport400JSONResponse{
+			Code:    ErrorCodeInvalidPath,
+			Message: "Invalid path",
+		}, nil
+	} else if err != nil {
+		return GetLogExport400JSONResponse{
+			Code:    ErrorCodeReadFailed,
+			Message: "Failed to open file",
+		}, nil
+	}
+
+	stripANSI := a.shouldStripANSI(request.Params.StripAnsi)
+
+	exporter := &logExporter{
+		file:      file,
+		reader:    bufio.NewReader(file),
+		match:     filter.matcher(redactor, stripANSI),
+		stripANSI: stripANSI,
+		redactor:  redactor,
+	}
+
+	headers := GetLogExport200ResponseHeaders{
+		ContentDisposition: mime.FormatMediaType("attachment", map[string]string{
+			"filename": path.Base(request.Params.Path) + "." + string(format),
+		}),
+	}
+
+	switch format {
+	case ExportFormatNdjson:
+		exporter.encode = exporter.encodeRecord
+
+		return GetLogExport200ApplicationxNdjsonResponse{
+			Body:    exporter,
+			Headers: headers,
+		}, nil
+	case ExportFormatCsv:
+		columns := exportColumns
+
+		if request.Params.Column != nil && len(*request.Params.Column) > 0 {
+			columns = *request.Params.Column
+		}
+
+		exporter.csv = csv.NewWriter(&exporter.buffer)
+		exporter.columns = columns
+		exporter.encode = exporter.encodeRow
+
+		if err := exporter.csv.Write(append([]string{"line"}, columns...)); err != nil {
+			file.Close()
+
+			return nil, err
+		}
+
+		exporter.csv.Flush()
+
+		return GetLogExport200TextcsvResponse{
+			Body:    exporter,
+			Headers: headers,
+		}, nil
+	default:
+		exporter.encode = exporter.encodeText
+
+		return GetLogExport200TextplainCharsetUtf8Response{
+			Body:    exporter,
+			Headers: headers,
+		}, nil
+	}
+}
+
+// logExporter reads the lines of a log matching a filter, encoding each as it
+// is read, so that exports of large logs are streamed rather than held in
+// memory.
+type logExporter struct {
+	file   io.Closer
+	reader *bufio.Reader
+
+	// match selects the lines to export, or is nil to export every line.
+	match func(line []byte) bool
+
+	stripANSI bool
+	redactor  *redact.Redactor
+
+	// encode writes a matching line, with its number within the log, to the
+	// buffer.
+	encode func(lineNumber int, line []byte) error
+
+	// csv writes the given columns of each line to the buffer, for CSV
+	// exports.
+	csv     *csv.Writer
+	columns []string
+
+	// buffer holds the encoded lines not yet read, and err the error reading
+	// the log, returned once the buffer has been read.
+	buffer     bytes.Buffer
+	lineNumber int
+	err        error
+}
+
+// exportedRecord is a line of an NDJSON export.
+type exportedRecord struct {
+	Line    int    `json:"line"`
+	Time    string `json:"time,omitempty"`
+	Level   string `json:"level,omitempty"`
+	Message string `json:"message"`
+
+	// Fields is the record of a structured line.
+	Fields json.RawMessage `json:"fields,omitempty"`
+}
+
+// Read reads the encoded lines, implementing the [io.Reader] interface.
+func (e *logExporter) Read(p []byte) (int, error) {
+	for e.buffer.Len() == 0 {
+		if e.err != nil {
+			return 0, e.err
+		}
+
+		e.err = e.next()
+	}
+
+	return e.buffer.Read(p)
+}
+
+// Close closes the log, implementing the [io.Closer] interface.
+func (e *logExporter) Close() error {
+	return e.file.Close()
+}
+
+// next reads the next line of the log, encoding it to the buffer if it
+// matches. [io.EOF] is returned once every line has been read.
+func (e *logExporter) next() error {
+	line, err := e.reader.ReadBytes('\n')
+	if len(line) == 0 && errors.Is(err, io.EOF) {
+		return io.EOF
+	} else if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	e.lineNumber++
+
+	if e.match != nil && !e.match(line) {
+		return nil
+	}
+
+	// Escape sequences are removed before redacting, so that they cannot
+	// split a secret.
+	if e.stripANSI {
+		line = ansi.Strip(line)
+	}
+
+	if e.redactor != nil {
+		line, _ = e.redactor.Redact(line)
+	}
+
+	return e.encode(e.lineNumber, line)
+}
+
+// encodeText writes the line as it is.
+func (e *logExporter) encodeText(lineNumber int, line []byte) error {
+	e.buffer.Write(line)
+	e.buffer.WriteByte('\n')
+
+	return nil
+}
+
+// encodeRecord writes the line as a JSON object, including the fields of a
+// structured line.
+func (e *logExporter) encodeRecord(lineNumber int, line []byte) error {
+	lineTime, level, message := summariseLine(line)
+
+	record := exportedRecord{
+		Line:    lineNumber,
+		Time:    lineTime,
+		Level:   level,
+		Message: message,
+	}
+
+	if _, ok := structured.Parse(line); ok {
+		var fields bytes.Buffer
+
+		if err := json.Compact(&fields, bytes.TrimSpace(line)); err == nil {
+			record.Fields = fields.Bytes()
+		}
+	}
+
+	encoder := json.NewEncoder(&e.buffer)
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(record)
+}
+
+// encodeRow writes the columns of the line as a CSV row, after its number.
+func (e *logExporter) encodeRow(lineNumber int, line []byte) error {
+	lineTime, level, message := summariseLine(line)
+
+	row := make([]string, 0, len(e.columns)+1)
+	row = append(row, strconv.Itoa(lineNumber))
+
+	for _, column := range e.columns {
+		switch column {
+		case "time":
+			row = append(row, lineTime)
+		case "level":
+			row = append(row, level)
+		case "message":
+			row = append(row, message)
+		default:
+			value, _ := structured.Lookup(line, column)
+			row = append(row, value)
+		}
+	}
+
+	if err := e.csv.Write(row); err != nil {
+		return err
+	}
+
+	e.csv.Flush()
+
+	return e.csv.Error()
+}
+
+// summariseLine returns the time, normalised level and message of a line,
+// which are taken from the fields of a structured line. The message of an
+// unstructured line is the whole line.
+func summariseLine(line []byte) (string, string, string) {
+	var lineTimeText, message string
+
+	if record, ok := structured.Parse(line); ok {
+		if record.Time != nil {
+			lineTimeText = record.Time.Text
+		}
+
+		if record.Message != nil {
+			message = record.Message.Text
+		}
+	} else {
+		message = string(line)
+
+		if t, ok := lineTime(line); ok {
+			lineTimeText = t.Format(time.RFC3339Nano)
+		}
+	}
+
+	return lineTimeText, structured.DetectLevel(line), message
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+	"github.com/crystalix007/log-viewer/redact"
+)
+
+// exportedLog is a log of structured and unstructured lines, holding secrets
+// which are redacted when exported.
+const exportedLog = `{"time":"2024-01-02T03:04:05Z","level":"info","msg":"started","http":{"status":200}}
+{"time":"2024-01-02T03:04:06Z","level":"error","msg":"failed","password":"hunter2","http":{"status":500}}
+2024-01-02T03:04:07Z ERROR plain, "quoted" token=secret123
+`
+
+func newExportAPI(t *testing.T) *API {
+	t.Helper()
+
+	pattern, err := redact.PatternDetector(`secret\d+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, _ := newFileAPI(t, map[string]string{"app.log": exportedLog},
+		WithRedaction(redact.New(redact.FieldDetector("password"), pattern)),
+	)
+
+	return a
+}
+
+func TestGetLogExport(t *testing.T) {
+	a := newExportAPI(t)
+
+	tests := []struct {
+		name        string
+		query       string
+		contentType string
+		filename    string
+		body        string
+	}{
+		{
+			name:        "text",
+			contentType: "text/plain; charset=utf-8",
+			filename:    "app.log.text",
+			body: `{"time":"2024-01-02T03:04:05Z","level":"info","msg":"started","http":{"status":200}}
+{"time":"2024-01-02T03:04:06Z","level":"error","msg":"failed","password":"[REDACTED]","http":{"status":500}}
+2024-01-02T03:04:07Z ERROR plain, "quoted" token=[REDACTED]
+`,
+		},
+		{
+			name:        "ndjson",
+			query:       "&export_format=ndjson",
+			contentType: "application/x-ndjson",
+			filename:    "app.log.ndjson",
+			body: `{"line":1,"time":"2024-01-02T03:04:05Z","level":"info","message":"started","fields":{"time":"2024-01-02T03:04:05Z","level":"info","msg":"started","http":{"status":200}}}
+{"line":2,"time":"2024-01-02T03:04:06Z","level":"error","message":"failed","fields":{"time":"2024-01-02T03:04:06Z","level":"error","msg":"failed","password":"[REDACTED]","http":{"status":500}}}
+{"line":3,"time":"2024-01-02T03:04:07Z","level":"error","message":"2024-01-02T03:04:07Z ERROR plain, \"quoted\" token=[REDACTED]"}
+`,
+		},
+		{
+			name:        "csv",
+			query:       "&export_format=csv",
+			contentType: "text/csv",
+			filename:    "app.log.csv",
+			body: `line,time,level,message
+1,2024-01-02T03:04:05Z,info,started
+2,2024-01-02T03:04:06Z,error,failed
+3,2024-01-02T03:04:07Z,error,"2024-01-02T03:04:07Z ERROR plain, ""quoted"" token=[REDACTED]"
+`,
+		},
+		{
+			name:        "csv columns",
+			query:       "&export_format=csv&column=http.status&column=msg",
+			contentType: "text/csv",
+			filename:    "app.log.csv",
+			body:        "line,http.status,msg\n1,200,started\n2,500,failed\n3,,\n",
+		},
+		{
+			name:        "level filter",
+			query:       "&export_format=csv&level=error&column=msg",
+			contentType: "text/csv",
+			filename:    "app.log.csv",
+			body:        "line,msg\n2,failed\n3,\n",
+		},
+		{
+			name:        "search filter",
+			query:       "&search=failed",
+			contentType: "text/plain; charset=utf-8",
+			filename:    "app.log.text",
+			body:        `{"time":"2024-01-02T03:04:06Z","level":"error","msg":"failed","password":"[REDACTED]","http":{"status":500}}` + "\n",
+		},
+		{
+			// Lines are redacted before they are searched, so that a search
+			// cannot reveal a secret.
+			name:        "search for a secret",
+			query:       "&search=hunter2",
+			contentType: "text/plain; charset=utf-8",
+			filename:    "app.log.text",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/api/log/export?path=prod/app.log"+test.query, nil)
+			recorder := httptest.NewRecorder()
+			a.ServeHTTP(recorder, request)
+
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("status = %d: %s", recorder.Code, recorder.Body)
+			}
+
+			if contentType := recorder.Header().Get("Content-Type"); contentType != test.contentType {
+				t.Errorf("Content-Type = %q, want %q", contentType, test.contentType)
+			}
+
+			if disposition := recorder.Header().Get("Content-Disposition"); disposition != "attachment; filename="+test.filename {
+				t.Errorf("Content-Disposition = %q, want the file %q", disposition, test.filename)
+			}
+
+			if recorder.Body.String() != test.body {
+				t.Errorf("body:\n%s\nwant:\n%s", recorder.Body, test.body)
+			}
+		})
+	}
+}
+
+func TestGetLogExportInvalidFormat(t *testing.T) {
+	a := newExportAPI(t)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/log/export?path=prod/app.log&export_format=xml", nil)
+	recorder := httptest.NewRecorder()
+	a.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want a bad request", recorder.Code)
+	}
+}
+
+func TestGetLogExportGzip(t *testing.T) {
+	a := newExportAPI(t)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/log/export?path=prod/app.log&search=started", nil)
+	request.Header.Set("Accept-Encoding", "gzip")
+
+	recorder := httptest.NewRecorder()
+	a.ServeHTTP(recorder, request)
+
+	if encoding := recorder.Header().Get("Content-Encoding"); encoding != "gzip" {
+		t.Fatalf("Content-Encoding = %q, want gzip", encoding)
+	}
+
+	reader, err := gzip.NewReader(recorder.Body)
+	if err != nil {
+		t.Fatalf("reading gzip: %v", err)
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("reading gzip: %v", err)
+	}
+
+	if want := `{"time":"2024-01-02T03:04:05Z","level":"info","msg":"started","http":{"status":200}}` + "\n"; string(body) != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+// TestLogPageFormsAvoidFormat checks that no control of the log page is named
+// after the format parameter, which would change the representation of the
+// page when the form is submitted.
+func TestLogPageFormsAvoidFormat(t *testing.T) {
+	a := newExportAPI(t)
+
+	request := httptest.NewRequest(http.MethodGet, "/log?path=prod%2Fapp.log", nil)
+	request.Header.Set("Accept", "text/html")
+
+	recorder := httptest.NewRecorder()
+	a.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", recorder.Code, recorder.Body)
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(recorder.Body.String()))
+
+	for tokenType := tokenizer.Next(); tokenType != html.ErrorToken; tokenType = tokenizer.Next() {
+		token := tokenizer.Token()
+
+		for _, attr := range token.Attr {
+			if attr.Key == "name" && attr.Val == kmiddleware.FormatParameter {
+				t.Errorf("the %s control is named %q", token.Data, attr.Val)
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/crystalix007/log-viewer/ansi"
+	"github.com/crystalix007/log-viewer/redact"
+	"github.com/crystalix007/log-viewer/structured"
+)
+
+// ErrUnknownLevel is returned when lines are filtered by a level which does
+// not exist.
+var ErrUnknownLevel = errors.New("api: unknown level")
+
+// lineFilter selects the lines of a log to return, by the filters of a
+// request.
+type lineFilter struct {
+	fields []structured.Filter
+
+	// search is the lowercased text that lines must contain.
+	search []byte
+
+	// levels are the normalised levels that lines must be logged at.
+	levels []string
+
+	since *time.Time
+	until *time.Time
+}
+
+// newLineFilter parses the filters of a request.
+func newLineFilter(
+	fields *FieldFilters,
+	search *Search,
+	levels *Levels,
+	since *SinceTime,
+	until *UntilTime,
+) (lineFilter, error) {
+	filter := lineFilter{
+		since: since,
+		until: until,
+	}
+
+	if fields != nil {
+		for _, field := range *fields {
+			fieldFilter, err := structured.ParseFilter(field)
+			if err != nil {
+				return lineFilter{}, err
+			}
+
+			filter.fields = append(filter.fields, fieldFilter)
+		}
+	}
+
+	if search != nil && *search != "" {
+		filter.search = bytes.ToLower([]byte(*search))
+	}
+
+	if levels != nil {
+		for _, level := range *levels {
+			normalised := structured.NormaliseLevel(level)
+			if normalised == "" {
+				return lineFilter{}, fmt.Errorf("%w: %q", ErrUnknownLevel, level)
+			}
+
+			filter.levels = append(filter.levels, normalised)
+		}
+	}
+
+	return filter, nil
+}
+
+// matcher returns the predicate selecting the lines matching all of the
+// filters, or nil if there are none. The predicate must be applied to every
+// line of a log in order, as lines without a timestamp are judged by that of
+// the previous line.
+//
+// Lines are matched as they are shown to the user, with escape sequences
+// removed if they are being stripped and then redacted, so that filters cannot
+// be used to discover redacted values, including those split by escape
+// sequences.
+func (f lineFilter) matcher(redactor *redact.Redactor, stripANSI bool) func(line []byte) bool {
+	if len(f.fields) == 0 && f.search == nil && f.levels == nil && f.since == nil && f.until == nil {
+		return nil
+	}
+
+	var lastTime *time.Time
+
+	return func(line []byte) bool {
+		if f.since != nil || f.until != nil {
+			if t, ok := lineTime(line); ok {
+				lastTime = &t
+			}
+
+			// Lines before the first timestamp cannot be placed in time, so
+			// are kept.
+			if lastTime != nil {
+				if f.since != nil && lastTime.Before(*f.since) {
+					return false
+				}
+
+				if f.until != nil && !lastTime.Before(*f.until) {
+					return false
+				}
+			}
+		}
+
+		if stripANSI {
+			line = ansi.Strip(line)
+		}
+
+		if redactor != nil {
+			line, _ = redactor.Redact(line)
+		}
+
+		if f.search != nil && !bytes.Contains(bytes.ToLower(line), f.search) {
+			return false
+		}
+
+		if f.levels != nil && !slices.Contains(f.levels, structured.DetectLevel(line)) {
+			return false
+		}
+
+		return structured.MatchAll(f.fields, line)
+	}
+}
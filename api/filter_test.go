@@ -0,0 +1,50 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/crystalix007/log-viewer/redact"
+)
+
+func TestMatcherStripsANSIBeforeRedacting(t *testing.T) {
+	detector, err := redact.PatternDetector(`secret\d+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	redactor := redact.New(detector)
+
+	// The escape sequence splits the secret, so that it is only found once
+	// the sequence is stripped.
+	line := []byte("token=sec\x1b[1mret123\x1b[0m")
+
+	tests := []struct {
+		search    string
+		redactor  *redact.Redactor
+		stripANSI bool
+		want      bool
+	}{
+		{search: "secret123", redactor: redactor, stripANSI: true, want: false},
+		{search: "secret123", redactor: nil, stripANSI: true, want: true},
+		{search: "token=", redactor: redactor, stripANSI: true, want: true},
+		{search: "secret123", redactor: nil, stripANSI: false, want: false},
+	}
+
+	for _, test := range tests {
+		search := Search(test.search)
+
+		filter, err := newLineFilter(nil, &search, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("newLineFilter: %v", err)
+		}
+
+		match := filter.matcher(test.redactor, test.stripANSI)
+
+		if got := match(line); got != test.want {
+			t.Errorf(
+				"searching for %q (redacted %v, stripped %v) matched %v, want %v",
+				test.search, test.redactor != nil, test.stripANSI, got, test.want,
+			)
+		}
+	}
+}
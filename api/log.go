@@ -1,21 +1,25 @@
 package api
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
-	"os"
+	"maps"
 	"path"
-	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/crystalix007/log-viewer/ansi"
+	"github.com/crystalix007/log-viewer/backend"
 )
 
 // ErrUnsafePath is returned when a path is unsafe, i.e. it escapes the working
 // directory.
-var ErrUnsafePath = errors.New("api: path is unsafe")
+var ErrUnsafePath = backend.ErrUnsafePath
 
 func (a *API) GetLog(
 	ctx context.Context,
@@ -23,113 +27,220 @@ func (a *API) GetLog(
 ) (GetLogResponseObject, error) {
 	if request.Params.Path == "" {
 		return GetLog400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
 			Message: "Requires a non-empty log path",
 		}, nil
 	}
 
+	if !a.allowed(ctx, request.Params.Path) {
+		return GetLog403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: accessDenied,
+		}, nil
+	}
+
 	name := path.Base(request.Params.Path)
 
-	path, err := a.getSafePath(request.Params.Path)
+	b, rootPath, err := a.resolvePath(request.Params.Path)
 	if err != nil {
-		return GetLog400JSONResponse{
-			Message: "Invalid path",
+		return GetLog404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified path does not exist",
 		}, nil
 	}
 
-	fileInfo, err := os.Stat(path)
-	if errors.Is(err, os.ErrNotExist) {
+	fileInfo, err := b.Stat(ctx, rootPath)
+	if errors.Is(err, backend.ErrNotExist) {
 		return GetLog404JSONResponse{
+			Code:    ErrorCodeNotFound,
 			Message: "The specified path does not exist",
 		}, nil
 	} else if err != nil {
 		return GetLog400JSONResponse{
+			Code:    ErrorCodeInvalidPath,
 			Message: "Invalid path",
 		}, nil
 	}
 
+	stats, err := a.logStats(ctx, b, request.Params.Path, fileInfo)
+	if err != nil {
+		return GetLog400JSONResponse{
+			Code:    ErrorCodeReadFailed,
+			Message: "Failed to read file",
+		}, nil
+	}
+
 	return GetLog200JSONResponse{
-		Name:     name,
-		Path:     request.Params.Path,
-		FileSize: int(fileInfo.Size()),
+		Name:         name,
+		Path:         request.Params.Path,
+		FileSize:     int(fileInfo.Size),
+		ModTime:      fileInfo.ModTime,
+		Identity:     fileIdentity(fileInfo),
+		LineCount:    stats.lines,
+		FirstTime:    stats.first,
+		LastTime:     stats.last,
+		Format:       LogFormat(stats.format),
+		Levels:       maps.Clone(stats.levels),
+		BeingWritten: fileInfo.Writing,
 	}, nil
 }
 
+// fileIdentity returns an identifier of the contents of a log file, which
+// changes whenever the file is modified.
+func fileIdentity(info backend.Info) string {
+	return fmt.Sprintf("%x-%x", info.ModTime.UnixNano(), info.Size)
+}
+
 const pageSize = 50
 
+// followTimeout is the longest time that a request following a live log will
+// wait for new lines to be written.
+var followTimeout = 10 * time.Second
+
 func (a *API) GetLogPage(
 	ctx context.Context,
 	request GetLogPageRequestObject,
 ) (GetLogPageResponseObject, error) {
 	if request.Params.Path == "" {
 		return GetLogPage400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
 			Message: "Requires a non-empty log path",
 		}, nil
 	}
 
-	path, err := a.getSafePath(request.Params.Path)
+	if !a.allowed(ctx, request.Params.Path) {
+		return GetLogPage403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: accessDenied,
+		}, nil
+	}
+
+	filter, err := newLineFilter(
+		request.Params.Field,
+		request.Params.Search,
+		request.Params.Level,
+		request.Params.SinceTime,
+		request.Params.UntilTime,
+	)
 	if err != nil {
 		return GetLogPage400JSONResponse{
-			Message: "Invalid path",
+			Code:    ErrorCodeInvalidRequest,
+			Message: err.Error(),
 		}, nil
 	}
 
-	file, err := os.Open(path)
-	if err != nil {
+	redactor := a.redactorFor(ctx)
+	stripANSI := a.shouldStripANSI(request.Params.StripAnsi)
+
+	ctx, cancel := followContext(ctx, request.Params.Follow)
+	defer cancel()
+
+	file, err := a.open(ctx, request.Params.Path, streamOptions(
+		request.Params.Follow,
+		request.Params.Previous,
+		request.Params.SinceTime,
+		request.Params.TailLines,
+		request.Params.Timestamps,
+	))
+	if errors.Is(err, backend.ErrNotExist) {
+		return GetLogPage404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified path does not exist",
+		}, nil
+	} else if errors.Is(err, backend.ErrUnsafePath) {
 		return GetLogPage400JSONResponse{
+			Code:    ErrorCodeInvalidPath,
+			Message: "Invalid path",
+		}, nil
+	} else if err != nil {
+		return GetLogPage400JSONResponse{
+			Code:    ErrorCodeReadFailed,
 			Message: "Failed to open file",
 		}, nil
 	}
 
 	defer file.Close()
 
-	bs, err := io.ReadAll(file)
+	var page, line int
+
+	if request.Params.Page != nil {
+		page = *request.Params.Page
+	}
+
+	if request.Params.Line != nil {
+		line = *request.Params.Line
+	}
+
+	events := a.logEvents(ctx, request.Params.Path)
+
+	logPage, err := readPage(file, page, line, len(events) > 0, filter.matcher(redactor, stripANSI))
+	if err != nil && ctx.Err() != nil && request.Params.Follow != nil && *request.Params.Follow {
+		// The follow timeout elapsed, so return the lines written so far.
+		err = nil
+	}
+
 	if err != nil {
 		return GetLogPage400JSONResponse{
+			Code:    ErrorCodeReadFailed,
 			Message: "Failed to read file",
 		}, nil
 	}
 
-	lines := bytes.Split(bs, []byte("\n"))
-	var contents types.File
-
-	var page int
-
-	if request.Params.Page != nil {
-		page = *request.Params.Page
+	// Escape sequences are removed before redacting, so that they cannot
+	// split a secret.
+	if stripANSI {
+		stripANSILines(logPage.lines)
 	}
 
-	if len(lines) > pageSize*page {
-		startIndex := pageSize * page
-		endIndex := pageSize * (page + 1)
+	redacted := redactLines(redactor, logPage.lines)
 
-		if endIndex > len(lines) {
-			endIndex = len(lines)
-		}
+	// The page is that of the requested line, if any.
+	page = logPage.page
+
+	var contents types.File
 
-		contents.InitFromBytes(bytes.Join(lines[startIndex:endIndex], []byte("\n")), path)
+	if len(logPage.lines) > 0 {
+		contents.InitFromBytes(bytes.Join(logPage.lines, []byte("\n")), request.Params.Path)
 	}
 
 	var (
-		previousPage *int
-		nextPage     *int
+		previousPage    *int
+		nextPage        *int
+		pageEvents      *[]LogEvent
+		pageAnnotations *[]Annotation
 	)
 
-	if page > 1 {
+	if page > 0 {
 		previousPage = new(int)
 		*previousPage = page - 1
 	}
 
-	if page+1 < len(lines)/pageSize {
+	if logPage.more {
 		nextPage = new(int)
 		*nextPage = page + 1
 	}
 
+	if len(logPage.lineNumbers) > 0 {
+		if annotations := a.annotationsOf(request.Params.Path, logPage.lineNumbers); len(annotations) > 0 {
+			pageAnnotations = &annotations
+		}
+	}
+
+	if len(events) > 0 {
+		pageEvents = new([]LogEvent)
+		*pageEvents = placeEvents(events, redactor, logPage.times, page == 0, logPage.nextTime())
+	}
+
 	return GetLogPage200JSONResponse{
 		PreviousPage: previousPage,
 		Page:         page,
 		NextPage:     nextPage,
 		Contents:     contents,
+		LineNumbers:  append([]int{}, logPage.lineNumbers...),
 		Path:         request.Params.Path,
+		Events:       pageEvents,
+		Annotations:  pageAnnotations,
+		Redacted:     redacted,
 	}, nil
 }
 
@@ -139,20 +250,41 @@ func (a *API) GetLogRaw(
 ) (GetLogRawResponseObject, error) {
 	if request.Params.Path == "" {
 		return GetLogRaw404JSONResponse{
+			Code:    ErrorCodeNotFound,
 			Message: "The specified path does not exist",
 		}, nil
 	}
 
-	path, err := a.getSafePath(request.Params.Path)
-	if err != nil {
-		return GetLogRaw400JSONResponse{
-			Message: "Invalid path",
+	if !a.allowed(ctx, request.Params.Path) {
+		return GetLogRaw403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: accessDenied,
 		}, nil
 	}
 
-	file, err := os.Open(path)
-	if err != nil {
+	ctx, cancel := followContext(ctx, request.Params.Follow)
+	defer cancel()
+
+	file, err := a.open(ctx, request.Params.Path, streamOptions(
+		request.Params.Follow,
+		request.Params.Previous,
+		request.Params.SinceTime,
+		request.Params.TailLines,
+		request.Params.Timestamps,
+	))
+	if errors.Is(err, backend.ErrNotExist) {
+		return GetLogRaw404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified path does not exist",
+		}, nil
+	} else if errors.Is(err, backend.ErrUnsafePath) {
 		return GetLogRaw400JSONResponse{
+			Code:    ErrorCodeInvalidPath,
+			Message: "Invalid path",
+		}, nil
+	} else if err != nil {
+		return GetLogRaw400JSONResponse{
+			Code:    ErrorCodeReadFailed,
 			Message: "Failed to open file",
 		}, nil
 	}
@@ -160,32 +292,214 @@ func (a *API) GetLogRaw(
 	defer file.Close()
 
 	bs, err := io.ReadAll(file)
+	if err != nil && ctx.Err() != nil && request.Params.Follow != nil && *request.Params.Follow {
+		// The follow timeout elapsed, so return the lines written so far.
+		err = nil
+	}
+
 	if err != nil {
 		return GetLogRaw400JSONResponse{
+			Code:    ErrorCodeReadFailed,
 			Message: "Failed to read file",
 		}, nil
 	}
 
+	if a.shouldStripANSI(request.Params.StripAnsi) {
+		bs = ansi.Strip(bs)
+	}
+
+	var redacted bool
+
+	if redactor := a.redactorFor(ctx); redactor != nil {
+		bs, redacted = redactor.RedactLines(bs)
+	}
+
 	var responseFile types.File
 
-	responseFile.InitFromBytes(bs, path)
+	responseFile.InitFromBytes(bs, request.Params.Path)
 
 	return GetLogRaw200JSONResponse{
 		Contents: responseFile,
+		Redacted: redacted,
 	}, nil
 }
 
-func (a *API) getSafePath(
+// open opens the log at the given path, passing the stream options to the
+// backend if it serves live logs.
+func (a *API) open(
+	ctx context.Context,
 	requestPath string,
-) (string, error) {
-	joinedPath := path.Join(a.workingDirectory, path.Clean(requestPath))
+	opts backend.StreamOptions,
+) (io.ReadCloser, error) {
+	b, rootPath, err := a.resolvePath(requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if streamer, ok := b.(backend.Streamer); ok {
+		return streamer.Stream(ctx, rootPath, opts)
+	}
+
+	return b.Open(ctx, rootPath)
+}
+
+// streamOptions converts the optional query parameters for live logs into
+// stream options.
+func streamOptions(
+	follow *Follow,
+	previous *Previous,
+	sinceTime *SinceTime,
+	tailLines *TailLines,
+	timestamps *Timestamps,
+) backend.StreamOptions {
+	var opts backend.StreamOptions
+
+	if follow != nil {
+		opts.Follow = *follow
+	}
+
+	if previous != nil {
+		opts.Previous = *previous
+	}
+
+	if timestamps != nil {
+		opts.Timestamps = *timestamps
+	}
+
+	opts.SinceTime = sinceTime
+	opts.TailLines = tailLines
+
+	return opts
+}
+
+// followContext bounds the context by the follow timeout, if the request
+// follows a live log.
+func followContext(
+	ctx context.Context,
+	follow *Follow,
+) (context.Context, context.CancelFunc) {
+	if follow == nil || !*follow {
+		return context.WithCancel(ctx)
+	}
 
-	workDirectory := path.Clean(a.workingDirectory)
-	workDirectoryPrefix := fmt.Sprintf("%s/", workDirectory)
+	return context.WithTimeout(ctx, followTimeout)
+}
+
+// logPage is a single page of lines read from a log.
+type logPage struct {
+	page  int
+	lines [][]byte
+
+	// lineNumbers holds the number of each line within the log, counting
+	// from 1.
+	lineNumbers []int
+
+	// times holds the time that each line was written, if requested. Lines
+	// without a timestamp inherit the time of the previous line.
+	times []time.Time
+
+	// more reports whether any lines follow the page, and next holds the
+	// time of the first of them.
+	more bool
+	next time.Time
+}
+
+// nextTime returns the time of the first line following the page, or nil if
+// the page is the last.
+func (p logPage) nextTime() *time.Time {
+	if !p.more {
+		return nil
+	}
+
+	return &p.next
+}
+
+// readPage reads the lines of the given page from the reader, optionally
+// recording the time that each line was written. If reading fails, the lines
+// read so far are returned alongside the error.
+//
+// If targetLine is positive, the page read is instead the one containing that
+// line, or the last page if the log is shorter. If match is non-nil, only the
+// lines that it matches are paged through and returned.
+//
+// Reading stops as soon as the page is complete, so backends which fetch
+// lazily only retrieve as much of the file as is needed.
+func readPage(
+	r io.Reader,
+	page int,
+	targetLine int,
+	withTimes bool,
+	match func(line []byte) bool,
+) (logPage, error) {
+	reader := bufio.NewReader(r)
+
+	var (
+		result   logPage
+		lastTime time.Time
+
+		// index is the index of the line among the matching lines, and
+		// lineNumber the number of the line within the log.
+		index      int
+		lineNumber int
+	)
 
-	if joinedPath != workDirectory && !strings.HasPrefix(joinedPath, workDirectoryPrefix) {
-		return "", ErrUnsafePath
+	// The page of a target line is unknown until the line is found, so each
+	// page is collected in turn until then.
+	seeking := targetLine > 0
+	if !seeking {
+		result.page = page
 	}
 
-	return joinedPath, nil
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) == 0 && errors.Is(err, io.EOF) {
+			return result, nil
+		} else if err != nil && !errors.Is(err, io.EOF) {
+			return result, err
+		}
+
+		line = bytes.TrimSuffix(line, []byte("\n"))
+		lineNumber++
+
+		if withTimes {
+			if lineTime, ok := lineTime(line); ok {
+				lastTime = lineTime
+			}
+		}
+
+		if match == nil || match(line) {
+			if seeking && index%pageSize == 0 {
+				result.page = index / pageSize
+				result.lines = nil
+				result.times = nil
+				result.lineNumbers = nil
+			}
+
+			if index >= pageSize*(result.page+1) {
+				result.more = true
+				result.next = lastTime
+
+				return result, nil
+			}
+
+			if index >= pageSize*result.page {
+				result.lines = append(result.lines, line)
+				result.lineNumbers = append(result.lineNumbers, lineNumber)
+
+				if withTimes {
+					result.times = append(result.times, lastTime)
+				}
+			}
+
+			if lineNumber >= targetLine {
+				seeking = false
+			}
+
+			index++
+		}
+
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+	}
 }
@@ -0,0 +1,337 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crystalix007/log-viewer/backend"
+)
+
+// liveBackend is a live backend serving a single log, which writes its lines
+// and then waits for more until the context is done, as a followed container
+// log does.
+type liveBackend struct {
+	log  string
+	opts backend.StreamOptions
+}
+
+func (l *liveBackend) ReadDir(ctx context.Context, path string) ([]backend.Entry, error) {
+	return nil, backend.ErrNotExist
+}
+
+func (l *liveBackend) Stat(ctx context.Context, path string) (backend.Info, error) {
+	if path != "/app/main" {
+		return backend.Info{}, backend.ErrNotExist
+	}
+
+	return backend.Info{Name: "main"}, nil
+}
+
+func (l *liveBackend) Open(ctx context.Context, path string) (io.ReadCloser, error) {
+	return l.Stream(ctx, path, backend.StreamOptions{})
+}
+
+func (l *liveBackend) Stream(
+	ctx context.Context,
+	path string,
+	opts backend.StreamOptions,
+) (io.ReadCloser, error) {
+	if _, err := l.Stat(ctx, path); err != nil {
+		return nil, err
+	}
+
+	l.opts = opts
+
+	reader := io.Reader(strings.NewReader(l.log))
+
+	if opts.Follow {
+		reader = io.MultiReader(reader, contextReader{ctx})
+	}
+
+	return io.NopCloser(reader), nil
+}
+
+// contextReader blocks until the context is done, returning its error.
+type contextReader struct {
+	ctx context.Context
+}
+
+func (c contextReader) Read(p []byte) (int, error) {
+	<-c.ctx.Done()
+
+	return 0, c.ctx.Err()
+}
+
+func TestGetLogPageFollowTimeout(t *testing.T) {
+	defer func(timeout time.Duration) {
+		followTimeout = timeout
+	}(followTimeout)
+
+	followTimeout = 100 * time.Millisecond
+
+	live := &liveBackend{log: "line 1\nline 2\n"}
+
+	a := newTestAPI(t, WithRoot("live", live))
+
+	follow := true
+	start := time.Now()
+
+	response, err := a.GetLogPage(context.Background(), GetLogPageRequestObject{
+		Params: GetLogPageParams{
+			Path:   "live/app/main",
+			Follow: &follow,
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetLogPage: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("the page was returned %v after the follow timeout", elapsed)
+	}
+
+	if !live.opts.Follow {
+		t.Error("the log was not followed")
+	}
+
+	page, ok := response.(GetLogPage200JSONResponse)
+	if !ok {
+		t.Fatalf("GetLogPage returned %#v, want the lines written before the timeout", response)
+	}
+
+	contents, err := page.Contents.Bytes()
+	if err != nil {
+		t.Fatalf("reading contents: %v", err)
+	}
+
+	if string(contents) != "line 1\nline 2" {
+		t.Errorf("contents = %q, want both lines", contents)
+	}
+
+	if page.NextPage != nil {
+		t.Errorf("next page = %d, want none", *page.NextPage)
+	}
+}
+
+func TestGetLogPageNotExist(t *testing.T) {
+	a := newTestAPI(t, WithRoot("live", &liveBackend{}))
+
+	response, err := a.GetLogPage(context.Background(), GetLogPageRequestObject{
+		Params: GetLogPageParams{
+			Path: "live/app/missing",
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetLogPage: %v", err)
+	}
+
+	if _, ok := response.(GetLogPage404JSONResponse); !ok {
+		t.Errorf("GetLogPage returned %#v, want Not Found", response)
+	}
+}
+
+// numberedLines returns a log of the given number of lines, "line 1" to
+// "line n".
+func numberedLines(n int) string {
+	var log strings.Builder
+
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&log, "line %d\n", i)
+	}
+
+	return log.String()
+}
+
+func TestReadPage(t *testing.T) {
+	even := func(line []byte) bool {
+		number, err := strconv.Atoi(strings.TrimPrefix(string(line), "line "))
+
+		return err == nil && number%2 == 0
+	}
+
+	tests := []struct {
+		name       string
+		page       int
+		targetLine int
+		match      func(line []byte) bool
+		wantPage   int
+		wantFirst  int
+		wantLast   int
+		wantMore   bool
+	}{
+		{name: "first page", wantFirst: 1, wantLast: 50, wantMore: true},
+		{name: "last page", page: 2, wantPage: 2, wantFirst: 101, wantLast: 120},
+		{name: "past the end", page: 5, wantPage: 5},
+		{name: "first line", targetLine: 1, wantFirst: 1, wantLast: 50, wantMore: true},
+		{name: "last line of a page", targetLine: 50, wantFirst: 1, wantLast: 50, wantMore: true},
+		{name: "first line of a page", targetLine: 51, wantPage: 1, wantFirst: 51, wantLast: 100, wantMore: true},
+		{name: "line overriding the page", page: 2, targetLine: 75, wantPage: 1, wantFirst: 51, wantLast: 100, wantMore: true},
+		{name: "last line", targetLine: 120, wantPage: 2, wantFirst: 101, wantLast: 120},
+		{name: "line past the end", targetLine: 500, wantPage: 2, wantFirst: 101, wantLast: 120},
+		{name: "matching lines", page: 1, match: even, wantPage: 1, wantFirst: 102, wantLast: 120},
+		{name: "matching line", targetLine: 100, match: even, wantFirst: 2, wantLast: 100, wantMore: true},
+		{name: "line before a match", targetLine: 101, match: even, wantPage: 1, wantFirst: 102, wantLast: 120},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			page, err := readPage(strings.NewReader(numberedLines(120)), test.page, test.targetLine, false, test.match)
+			if err != nil {
+				t.Fatalf("readPage: %v", err)
+			}
+
+			if page.page != test.wantPage || page.more != test.wantMore {
+				t.Errorf("read page %d with more %t, want page %d with more %t", page.page, page.more, test.wantPage, test.wantMore)
+			}
+
+			if len(page.lines) != len(page.lineNumbers) {
+				t.Fatalf("read %d lines with %d line numbers", len(page.lines), len(page.lineNumbers))
+			}
+
+			for i, line := range page.lines {
+				if want := fmt.Sprintf("line %d", page.lineNumbers[i]); string(line) != want {
+					t.Errorf("line number %d is %q, want %q", page.lineNumbers[i], line, want)
+				}
+			}
+
+			var first, last int
+			if len(page.lineNumbers) > 0 {
+				first, last = page.lineNumbers[0], page.lineNumbers[len(page.lineNumbers)-1]
+			}
+
+			if first != test.wantFirst || last != test.wantLast {
+				t.Errorf("read lines %d to %d, want %d to %d", first, last, test.wantFirst, test.wantLast)
+			}
+		})
+	}
+}
+
+func TestReadPageTimes(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// Odd lines are timestamped, and even lines inherit the time of the line
+	// before them.
+	var log strings.Builder
+
+	for i := 1; i <= pageSize+1; i++ {
+		if i%2 == 1 {
+			fmt.Fprintf(&log, "%s line %d\n", start.Add(time.Duration(i)*time.Second).Format(time.RFC3339), i)
+		} else {
+			fmt.Fprintf(&log, "line %d\n", i)
+		}
+	}
+
+	page, err := readPage(strings.NewReader(log.String()), 0, 0, true, nil)
+	if err != nil {
+		t.Fatalf("readPage: %v", err)
+	}
+
+	if len(page.times) != pageSize {
+		t.Fatalf("read %d times, want %d", len(page.times), pageSize)
+	}
+
+	for i, lineTime := range page.times {
+		number := page.lineNumbers[i]
+		want := start.Add(time.Duration(number-(1-number%2)) * time.Second)
+
+		if !lineTime.Equal(want) {
+			t.Errorf("line %d written at %v, want %v", number, lineTime, want)
+		}
+	}
+
+	if next := page.nextTime(); next == nil || !next.Equal(start.Add(51*time.Second)) {
+		t.Errorf("next page starts at %v, want %v", next, start.Add(51*time.Second))
+	}
+}
+
+func TestGetLogPageLine(t *testing.T) {
+	a, _ := newFileAPI(t, map[string]string{"app.log": numberedLines(120)})
+
+	line := 75
+
+	response, err := a.GetLogPage(context.Background(), GetLogPageRequestObject{
+		Params: GetLogPageParams{
+			Path: "prod/app.log",
+			Line: &line,
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetLogPage: %v", err)
+	}
+
+	page, ok := response.(GetLogPage200JSONResponse)
+	if !ok {
+		t.Fatalf("GetLogPage returned %#v, want a page", response)
+	}
+
+	if page.Page != 1 || len(page.LineNumbers) != pageSize || page.LineNumbers[0] != 51 {
+		t.Errorf("GetLogPage of line %d returned page %d of lines %v, want page 1 from line 51", line, page.Page, page.LineNumbers)
+	}
+
+	if page.PreviousPage == nil || *page.PreviousPage != 0 || page.NextPage == nil || *page.NextPage != 2 {
+		t.Errorf("GetLogPage returned previous page %v and next page %v, want 0 and 2", page.PreviousPage, page.NextPage)
+	}
+}
+
+func TestLogPagePinned(t *testing.T) {
+	a, dir := newFileAPI(t, map[string]string{"app.log": "line 1\nline 2\n"})
+
+	response, err := a.GetLog(context.Background(), GetLogRequestObject{
+		Params: GetLogParams{Path: "prod/app.log"},
+	})
+	if err != nil {
+		t.Fatalf("GetLog: %v", err)
+	}
+
+	details, ok := response.(GetLog200JSONResponse)
+	if !ok {
+		t.Fatalf("GetLog returned %#v, want the log's details", response)
+	}
+
+	pinned := details.Identity
+
+	changed := func() bool {
+		t.Helper()
+
+		request := httptest.NewRequest(http.MethodGet, "/log?path=prod%2Fapp.log&pin="+url.QueryEscape(pinned), nil)
+		request.Header.Set("Accept", "text/html")
+
+		recorder := httptest.NewRecorder()
+		a.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("status = %d: %s", recorder.Code, recorder.Body)
+		}
+
+		return strings.Contains(recorder.Body.String(), "This log has changed since this link was created")
+	}
+
+	if changed() {
+		t.Error("the page of an unchanged log warned that it has changed")
+	}
+
+	file, err := os.OpenFile(filepath.Join(dir, "app.log"), os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := file.WriteString("line 3\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	file.Close()
+
+	if !changed() {
+		t.Error("the page of a changed log did not warn that it has changed")
+	}
+}
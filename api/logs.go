@@ -3,8 +3,9 @@ package api
 import (
 	"context"
 	"errors"
-	"os"
 	"path"
+
+	"github.com/crystalix007/log-viewer/backend"
 )
 
 // GetLogs retrieves logs from the log viewing service.
@@ -12,37 +13,94 @@ func (a *API) GetLogs(
 	ctx context.Context,
 	request GetLogsRequestObject,
 ) (GetLogsResponseObject, error) {
-	requestPath := "/"
+	var requestPath string
 
 	if request.Params.Path != nil {
-		requestPath = *request.Params.Path
+		requestPath = cleanPath(*request.Params.Path)
 	}
 
-	directory, err := a.getSafePath(requestPath)
-	if err != nil {
-		return GetLogs400JSONResponse{
-			Message: "Invalid path",
+	b, rootPath, err := a.resolvePath(requestPath)
+	if errors.Is(err, backend.ErrNotExist) && requestPath == "" {
+		return a.listRoots(ctx), nil
+	} else if err != nil {
+		return GetLogs404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified path does not exist",
+		}, nil
+	}
+
+	if !a.browsable(ctx, requestPath) {
+		return GetLogs403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: accessDenied,
 		}, nil
 	}
 
-	direntries, err := os.ReadDir(directory)
-	if errors.Is(err, os.ErrNotExist) {
+	entries, err := b.ReadDir(ctx, rootPath)
+	if errors.Is(err, backend.ErrNotExist) {
 		return GetLogs404JSONResponse{
+			Code:    ErrorCodeNotFound,
 			Message: "The specified path does not exist",
 		}, nil
+	} else if errors.Is(err, backend.ErrUnsafePath) {
+		return GetLogs400JSONResponse{
+			Code:    ErrorCodeInvalidPath,
+			Message: "Invalid path",
+		}, nil
+	} else if err != nil {
+		return GetLogs500JSONResponse{
+			Code:    ErrorCodeInternal,
+			Message: "Failed to list logs",
+		}, nil
 	}
 
 	response := GetLogs200JSONResponse{
-		Logfiles: make([]LogFile, len(direntries)),
+		Logfiles: make([]LogFile, 0, len(entries)),
 	}
 
-	for i, direntry := range direntries {
-		response.Logfiles[i] = LogFile{
-			Name: direntry.Name(),
-			Path: path.Join(requestPath, direntry.Name()),
-			Dir:  direntry.IsDir(),
+	for _, entry := range entries {
+		entryPath := path.Join(requestPath, entry.Name)
+
+		// Entries the user may not view are hidden, rather than failing the
+		// whole listing.
+		if !a.visible(ctx, entryPath, entry.Dir) {
+			continue
 		}
+
+		logFile := LogFile{
+			Name: entry.Name,
+			Path: entryPath,
+			Dir:  entry.Dir,
+		}
+
+		if entry.SymlinkTarget != "" {
+			logFile.SymlinkTarget = &entry.SymlinkTarget
+		}
+
+		response.Logfiles = append(response.Logfiles, logFile)
 	}
 
 	return response, nil
 }
+
+// listRoots lists the roots as directories, so that they can be browsed from
+// the top level.
+func (a *API) listRoots(ctx context.Context) GetLogs200JSONResponse {
+	response := GetLogs200JSONResponse{
+		Logfiles: make([]LogFile, 0, len(a.roots)),
+	}
+
+	for _, root := range a.roots {
+		if !a.browsable(ctx, root.name) {
+			continue
+		}
+
+		response.Logfiles = append(response.Logfiles, LogFile{
+			Name: root.name,
+			Path: root.name,
+			Dir:  true,
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetLogsPaths(t *testing.T) {
+	a, _ := newFileAPI(t, map[string]string{"app.log": "line\n"})
+
+	// However a path is written, the paths listed are canonical, so that they
+	// can be compared with those of other requests.
+	for requestPath, want := range map[string]string{
+		"":             "prod",
+		"/":            "prod",
+		"prod":         "prod/app.log",
+		"/prod/":       "prod/app.log",
+		"prod/../prod": "prod/app.log",
+	} {
+		response, err := a.GetLogs(context.Background(), GetLogsRequestObject{
+			Params: GetLogsParams{Path: &requestPath},
+		})
+		if err != nil {
+			t.Fatalf("GetLogs(%q): %v", requestPath, err)
+		}
+
+		logs, ok := response.(GetLogs200JSONResponse)
+		if !ok {
+			t.Fatalf("GetLogs(%q) returned %#v", requestPath, response)
+		}
+
+		if len(logs.Logfiles) != 1 || logs.Logfiles[0].Path != want {
+			t.Errorf("GetLogs(%q) listed %+v, want the path %q", requestPath, logs.Logfiles, want)
+		}
+	}
+
+	roots, err := a.GetRoots(context.Background(), GetRootsRequestObject{})
+	if err != nil {
+		t.Fatalf("GetRoots: %v", err)
+	}
+
+	if roots := roots.(GetRoots200JSONResponse).Roots; len(roots) != 1 || roots[0].Path != "prod" {
+		t.Errorf("GetRoots listed %+v, want the path %q", roots, "prod")
+	}
+}
@@ -3,31 +3,165 @@ package api
 import (
 	"fmt"
 	"os"
+
+	"github.com/crystalix007/log-viewer/audit"
+	"github.com/crystalix007/log-viewer/authz"
+	"github.com/crystalix007/log-viewer/backend"
+	"github.com/crystalix007/log-viewer/backend/filesystem"
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+	"github.com/crystalix007/log-viewer/redact"
+	"github.com/crystalix007/log-viewer/store"
 )
 
 // Option represents a value that can be configured on an API.
 type Option func(a *API)
 
 // WithWorkingDirectory sets the working directory on the API.
+//
+// The working directory is served as the [DefaultRoot] using a filesystem
+// backend, unless another backend is configured with [WithBackend].
 func WithWorkingDirectory(workingDirectory string) Option {
 	return func(a *API) {
 		a.workingDirectory = workingDirectory
 	}
 }
 
+// WithBackend sets the backend that the [DefaultRoot] is served from.
+func WithBackend(b backend.Backend) Option {
+	return func(a *API) {
+		a.backend = b
+	}
+}
+
+// WithRoot adds a named root, serving logs from the given backend.
+func WithRoot(name string, b backend.Backend) Option {
+	return func(a *API) {
+		a.roots = append(a.roots, root{
+			name:    name,
+			backend: b,
+		})
+	}
+}
+
+// WithAuthentication requires that every request is authenticated by one of
+// the given authenticators.
+func WithAuthentication(authenticators ...kmiddleware.Authenticator) Option {
+	return func(a *API) {
+		a.authenticators = append(a.authenticators, authenticators...)
+	}
+}
+
+// WithAuthorization restricts the logs that each authenticated user may view
+// to those allowed by the policy.
+func WithAuthorization(policy *authz.Policy) Option {
+	return func(a *API) {
+		a.policy = policy
+	}
+}
+
+// WithRedaction masks secrets and personal data in the logs shown to users,
+// unless the authorization policy permits them to view logs unredacted.
+func WithRedaction(redactor *redact.Redactor) Option {
+	return func(a *API) {
+		a.redactor = redactor
+	}
+}
+
+// WithAuditLog records every request which reads log content in the audit
+// log.
+func WithAuditLog(log *audit.Log) Option {
+	return func(a *API) {
+		a.audit = log
+	}
+}
+
+// WithANSIStripping removes ANSI escape sequences from the contents of logs
+// served by the API by default, unless a request asks to keep them. The bytes
+// of logs are always served as stored.
+func WithANSIStripping() Option {
+	return func(a *API) {
+		a.stripANSI = true
+	}
+}
+
+// WithStateDirectory sets the directory that the state of the viewer, such as
+// annotations, is stored in. It defaults to [DefaultStateDirectory], and must
+// not be within the directory of any root, where the state would be served.
+func WithStateDirectory(dir string) Option {
+	return func(a *API) {
+		a.stateDirectory = dir
+	}
+}
+
+// WithTemplateDirectory overlays the templates in the directory on the
+// embedded templates, so that pages are rendered with a template from the
+// directory if it exists, and with the embedded template otherwise.
+func WithTemplateDirectory(dir string) Option {
+	return func(a *API) {
+		a.templateDirectory = dir
+	}
+}
+
+// WithTemplateReload reloads templates whenever they change, which is intended
+// for developing templates in the template directory. It requires
+// [WithTemplateDirectory].
+func WithTemplateReload() Option {
+	return func(a *API) {
+		a.reloadTemplates = true
+	}
+}
+
 // setDefaults sets the default values on the API.
 func (a *API) setDefaults() error {
 	var err error
 
-	if a.workingDirectory == "" {
-		a.workingDirectory, err = os.Getwd()
+	// The working directory is only served by default if no other roots are
+	// configured.
+	if a.backend == nil && (a.workingDirectory != "" || len(a.roots) == 0) {
+		if a.workingDirectory == "" {
+			a.workingDirectory, err = os.Getwd()
+			if err != nil {
+				return fmt.Errorf(
+					"api: getting working directory: %w",
+					err,
+				)
+			}
+		}
+
+		a.backend = filesystem.New(a.workingDirectory)
+	}
+
+	if a.backend != nil {
+		a.roots = append([]root{{
+			name:    DefaultRoot,
+			backend: a.backend,
+		}}, a.roots...)
+	}
+
+	if err := a.validateRoots(); err != nil {
+		return err
+	}
+
+	if a.stateDirectory == "" {
+		a.stateDirectory, err = DefaultStateDirectory()
 		if err != nil {
-			return fmt.Errorf(
-				"api: getting working directory: %w",
-				err,
-			)
+			return err
 		}
 	}
 
+	if err := a.validateStateDirectory(); err != nil {
+		return err
+	}
+
+	a.annotations, err = store.Open[Annotation](a.stateDirectory, annotationsCollection)
+	if err != nil {
+		return fmt.Errorf("api: opening annotations: %w", err)
+	}
+
+	a.views, err = store.Open[View](a.stateDirectory, viewsCollection)
+	if err != nil {
+		return fmt.Errorf("api: opening views: %w", err)
+	}
+
 	return nil
 }
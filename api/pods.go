@@ -0,0 +1,579 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/crystalix007/log-viewer/ansi"
+	"github.com/crystalix007/log-viewer/backend"
+)
+
+// GetPodsLogs retrieves the merged logs of all containers in the pods matching
+// a label selector.
+func (a *API) GetPodsLogs(
+	ctx context.Context,
+	request GetPodsLogsRequestObject,
+) (GetPodsLogsResponseObject, error) {
+	// The namespace is a single segment of the paths of the pods within the
+	// root, so must not reach into another namespace or root.
+	namespace := request.Params.Namespace
+	if namespace == "" || namespace == "." || namespace == ".." || strings.Contains(namespace, "/") {
+		return GetPodsLogs400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: "Requires a non-empty namespace, without slashes",
+		}, nil
+	}
+
+	rootName := DefaultRoot
+
+	if request.Params.Root != nil {
+		rootName = *request.Params.Root
+	}
+
+	b, ok := a.rootBackend(rootName)
+	if !ok {
+		return GetPodsLogs404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified root does not exist",
+		}, nil
+	}
+
+	if !a.browsable(ctx, path.Join(rootName, request.Params.Namespace)) {
+		return GetPodsLogs403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: "Access to the specified namespace is denied",
+		}, nil
+	}
+
+	pods, err := backend.SelectPods(
+		ctx,
+		b,
+		request.Params.Namespace,
+		request.Params.Selector,
+	)
+	if errors.Is(err, backend.ErrInvalidSelector) {
+		return GetPodsLogs400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: "Invalid label selector",
+		}, nil
+	} else if errors.Is(err, backend.ErrNotExist) {
+		return GetPodsLogs404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified namespace does not exist",
+		}, nil
+	} else if errors.Is(err, backend.ErrUnsafePath) {
+		return GetPodsLogs400JSONResponse{
+			Code:    ErrorCodeInvalidPath,
+			Message: "Invalid namespace",
+		}, nil
+	} else if err != nil {
+		return GetPodsLogs500JSONResponse{
+			Code:    ErrorCodeInternal,
+			Message: "Failed to select pods",
+		}, nil
+	}
+
+	pods = a.allowedPods(ctx, rootName, pods)
+
+	response := GetPodsLogs200JSONResponse{
+		Root:      rootName,
+		Namespace: request.Params.Namespace,
+		Selector:  request.Params.Selector,
+		Pods:      make([]PodDetails, len(pods)),
+		Lines:     []PodLogLine{},
+	}
+
+	var cursor podCursor
+
+	if request.Params.Cursor != nil && *request.Params.Cursor != "" {
+		cursor, err = decodePodCursor(*request.Params.Cursor)
+		if err != nil {
+			return GetPodsLogs400JSONResponse{
+				Code:    ErrorCodeInvalidRequest,
+				Message: "Invalid cursor",
+			}, nil
+		}
+	}
+
+	var sources []*podLogSource
+
+	defer func() {
+		for _, source := range sources {
+			source.Close()
+		}
+	}()
+
+	for i, pod := range pods {
+		response.Pods[i] = PodDetails{
+			Name:       pod.Name,
+			Containers: pod.Containers,
+		}
+
+		for _, container := range pod.Containers {
+			source, err := a.openPodLogSource(
+				ctx,
+				b,
+				path.Join(rootName, pod.ContainerPath(container)),
+				pod.Name,
+				container,
+				request.Params.SinceTime,
+				cursor[path.Join(pod.Name, container)],
+			)
+			if err != nil {
+				// Containers which have not yet started have no logs to
+				// show, so should not prevent viewing the others.
+				slog.WarnContext(
+					ctx,
+					"failed to open container log",
+					slog.String("pod", pod.Name),
+					slog.String("container", container),
+					slog.Any("error", err),
+				)
+
+				continue
+			}
+
+			sources = append(sources, source)
+		}
+	}
+
+	if request.Params.Page != nil {
+		response.Page = *request.Params.Page
+	}
+
+	var events []backend.Event
+
+	for _, pod := range pods {
+		events = append(events, a.podEvents(ctx, path.Join(rootName, pod.Namespace, pod.Name))...)
+	}
+
+	slices.SortStableFunc(events, func(a, b backend.Event) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	redactor := a.redactorFor(ctx)
+	stripANSI := a.shouldStripANSI(request.Params.StripAnsi)
+
+	startIndex := pageSize * response.Page
+	endIndex := pageSize * (response.Page + 1)
+
+	// A cursor resumes each log where the previous page ended, so the page
+	// starts with the first line read.
+	if cursor != nil {
+		startIndex = 0
+		endIndex = pageSize
+	}
+
+	var (
+		lineTimes []time.Time
+		nextTime  *time.Time
+	)
+
+	for index := 0; ; index++ {
+		source := earliestSource(sources)
+		if source == nil {
+			break
+		}
+
+		if index >= endIndex {
+			response.NextPage = new(int)
+			*response.NextPage = response.Page + 1
+
+			nextTime = new(time.Time)
+			*nextTime = source.time
+
+			nextCursor, err := encodePodCursor(sources)
+			if err != nil {
+				return nil, err
+			}
+
+			response.NextCursor = &nextCursor
+
+			break
+		}
+
+		if index >= startIndex {
+			contentsLine := source.line
+
+			if stripANSI {
+				contentsLine = ansi.Strip(contentsLine)
+			}
+
+			if redactor != nil {
+				var redacted bool
+
+				contentsLine, redacted = redactor.Redact(contentsLine)
+				response.Redacted = response.Redacted || redacted
+			}
+
+			var contents types.File
+
+			contents.InitFromBytes(contentsLine, source.container)
+
+			line := PodLogLine{
+				Pod:       source.pod,
+				Container: source.container,
+				Contents:  contents,
+			}
+
+			if source.hasTime {
+				line.Time = new(time.Time)
+				*line.Time = source.time
+			}
+
+			response.Lines = append(response.Lines, line)
+			lineTimes = append(lineTimes, source.time)
+		}
+
+		if err := source.next(); err != nil {
+			return GetPodsLogs500JSONResponse{
+				Code:    ErrorCodeReadFailed,
+				Message: "Failed to read logs",
+			}, nil
+		}
+	}
+
+	if len(events) > 0 {
+		response.Events = new([]LogEvent)
+		*response.Events = placeEvents(events, redactor, lineTimes, response.Page == 0, nextTime)
+	}
+
+	if response.Page > 0 {
+		response.PreviousPage = new(int)
+		*response.PreviousPage = response.Page - 1
+	}
+
+	return response, nil
+}
+
+// allowedPods restricts the pods to the containers that the user making the
+// request may view, omitting pods with none.
+func (a *API) allowedPods(ctx context.Context, rootName string, pods []backend.Pod) []backend.Pod {
+	var allowed []backend.Pod
+
+	for _, pod := range pods {
+		containers := slices.DeleteFunc(slices.Clone(pod.Containers), func(container string) bool {
+			return !a.allowed(ctx, path.Join(rootName, pod.ContainerPath(container)))
+		})
+
+		if len(containers) == 0 {
+			continue
+		}
+
+		pod.Containers = containers
+		allowed = append(allowed, pod)
+	}
+
+	return allowed
+}
+
+// podLogSource reads the log of a single container, one line at a time.
+type podLogSource struct {
+	pod       string
+	container string
+	file      io.ReadCloser
+	reader    *bufio.Reader
+
+	// line is the current line, and time the time it was written. Lines
+	// without a timestamp are placed by their neighbours: they inherit the
+	// time of the previous line, or of the next if they lead the log.
+	line    []byte
+	time    time.Time
+	hasTime bool
+	done    bool
+
+	// pending holds the lines read ahead of the current line, to find the
+	// time of leading lines without a timestamp, and offset the number of
+	// bytes read from the log.
+	pending []sourceLine
+	offset  int64
+
+	// consumed records how far the lines taken from the source extend, for
+	// the cursor of the next page.
+	consumed sourceCursor
+}
+
+// sourceLine is a line read from a container log, with the offset of the
+// byte following it.
+type sourceLine struct {
+	line    []byte
+	time    time.Time
+	hasTime bool
+	end     int64
+}
+
+// maxLookahead is the number of lines without a timestamp which are read
+// ahead at the start of a log, to place them by the first timestamp.
+const maxLookahead = 1000
+
+// openPodLogSource opens the log of a container, resuming where the cursor
+// records that it was last read, if set.
+//
+// Logs which can be read at random offsets are resumed at the byte that the
+// previous page ended at. Live logs are instead resumed from the time of the
+// last line read, skipping the lines at that time which were already shown,
+// and other logs are read from the start up to the offset.
+func (a *API) openPodLogSource(
+	ctx context.Context,
+	b backend.Backend,
+	logPath string,
+	pod string,
+	container string,
+	sinceTime *time.Time,
+	cursor sourceCursor,
+) (*podLogSource, error) {
+	_, live := b.(backend.Streamer)
+	resumeByTime := live && cursor.Time != nil
+
+	opts := backend.StreamOptions{
+		SinceTime:  sinceTime,
+		Timestamps: true,
+	}
+
+	if resumeByTime {
+		opts.SinceTime = cursor.Time
+	}
+
+	file, err := a.open(ctx, logPath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &podLogSource{
+		pod:       pod,
+		container: container,
+		file:      file,
+		consumed:  cursor,
+	}
+
+	seeker, seekable := file.(io.Seeker)
+
+	switch {
+	case resumeByTime:
+		source.reader = bufio.NewReader(file)
+	case seekable && cursor.Offset > 0:
+		if _, err := seeker.Seek(cursor.Offset, io.SeekStart); err != nil {
+			file.Close()
+
+			return nil, err
+		}
+
+		source.offset = cursor.Offset
+		source.reader = bufio.NewReader(file)
+	default:
+		source.reader = bufio.NewReader(file)
+
+		if _, err := io.CopyN(io.Discard, source.reader, cursor.Offset); err != nil && !errors.Is(err, io.EOF) {
+			file.Close()
+
+			return nil, err
+		}
+
+		source.offset = cursor.Offset
+	}
+
+	if err := source.next(); err != nil {
+		file.Close()
+
+		return nil, err
+	}
+
+	// The lines at the time of the cursor may have been shown already, as
+	// live logs are only resumed from a whole second.
+	if resumeByTime {
+		for skipped := 0; !source.done; {
+			if !source.time.Before(*cursor.Time) {
+				if !source.time.Equal(*cursor.Time) || skipped >= cursor.AtTime {
+					break
+				}
+
+				skipped++
+			}
+
+			if err := source.next(); err != nil {
+				file.Close()
+
+				return nil, err
+			}
+		}
+
+		// The skipped lines were counted by the cursor already.
+		source.consumed = cursor
+	}
+
+	return source, nil
+}
+
+// next advances the source to its next line, recording the current line as
+// consumed.
+func (s *podLogSource) next() error {
+	if s.line != nil {
+		s.consume()
+	}
+
+	if len(s.pending) == 0 {
+		if err := s.readAhead(); err != nil {
+			return err
+		}
+	}
+
+	if len(s.pending) == 0 {
+		s.done = true
+		s.line = nil
+
+		return nil
+	}
+
+	current := s.pending[0]
+	s.pending = s.pending[1:]
+
+	s.line = current.line
+	s.time = current.time
+	s.hasTime = current.hasTime
+	s.offset = current.end
+
+	return nil
+}
+
+// consume records the current line as read, for the cursor of the next page.
+func (s *podLogSource) consume() {
+	s.consumed.Offset = s.offset
+
+	if s.consumed.Time != nil && s.consumed.Time.Equal(s.time) {
+		s.consumed.AtTime++
+	} else {
+		lineTime := s.time
+		s.consumed.Time = &lineTime
+		s.consumed.AtTime = 1
+	}
+}
+
+// readAhead reads the next line into the pending lines. Lines without a
+// timestamp inherit the time of the previous line, but if there is none,
+// lines are read ahead until one has a timestamp, which they all take.
+func (s *podLogSource) readAhead() error {
+	end := s.offset
+
+	if len(s.pending) > 0 {
+		end = s.pending[len(s.pending)-1].end
+	}
+
+	for len(s.pending) < maxLookahead {
+		line, err := s.reader.ReadBytes('\n')
+		if len(line) == 0 && errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		end += int64(len(line))
+
+		current := sourceLine{
+			line: bytes.TrimSuffix(line, []byte("\n")),
+			time: s.time,
+			end:  end,
+		}
+
+		if lineTime, ok := lineTime(current.line); ok {
+			current.time = lineTime
+			current.hasTime = true
+
+			for i := range s.pending {
+				s.pending[i].time = lineTime
+			}
+
+			s.time = lineTime
+		}
+
+		s.pending = append(s.pending, current)
+
+		if current.hasTime || !s.time.IsZero() || errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// Close closes the underlying log.
+func (s *podLogSource) Close() error {
+	return s.file.Close()
+}
+
+// earliestSource returns the source whose current line was written first, or
+// nil if all sources are exhausted.
+func earliestSource(sources []*podLogSource) *podLogSource {
+	var earliest *podLogSource
+
+	for _, source := range sources {
+		if source.done {
+			continue
+		}
+
+		if earliest == nil || source.time.Before(earliest.time) {
+			earliest = source
+		}
+	}
+
+	return earliest
+}
+
+// podCursor records how far the log of each container has been read, by
+// "<pod>/<container>", so that the next page resumes where the last ended.
+type podCursor map[string]sourceCursor
+
+// sourceCursor records how far the log of a container has been read.
+type sourceCursor struct {
+	// Offset is the number of bytes read from the log.
+	Offset int64 `json:"offset,omitempty"`
+
+	// Time is the time of the last line read, and AtTime the number of lines
+	// read at that time.
+	Time   *time.Time `json:"time,omitempty"`
+	AtTime int        `json:"at_time,omitempty"`
+}
+
+// encodePodCursor encodes how far each source has been read as an opaque
+// cursor.
+func encodePodCursor(sources []*podLogSource) (string, error) {
+	cursor := make(podCursor, len(sources))
+
+	for _, source := range sources {
+		cursor[path.Join(source.pod, source.container)] = source.consumed
+	}
+
+	encoded, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// decodePodCursor decodes a cursor encoded by [encodePodCursor].
+func decodePodCursor(encoded string) (podCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor podCursor
+
+	if err := json.Unmarshal(decoded, &cursor); err != nil {
+		return nil, err
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,279 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crystalix007/log-viewer/backend"
+	"github.com/crystalix007/log-viewer/backend/filesystem"
+)
+
+var podsTestStart = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// podLog returns the lines of a container log, each timestamped at the given
+// interval from the start, with the given number of lines without timestamps
+// leading the log.
+func podLog(name string, lines int, offset time.Duration, interval time.Duration, leading int) []string {
+	var log []string
+
+	for i := range leading {
+		log = append(log, fmt.Sprintf("%s leading %d", name, i))
+	}
+
+	for i := range lines {
+		lineTime := podsTestStart.Add(offset + time.Duration(i)*interval)
+		log = append(log, fmt.Sprintf("%s %s line %d", lineTime.Format(time.RFC3339Nano), name, i))
+	}
+
+	return log
+}
+
+// livePods is a live backend serving the logs of pods, which, like the
+// Kubernetes API server, only resumes logs from a whole second.
+type livePods struct {
+	logs map[string][]string
+}
+
+func (l *livePods) ReadDir(ctx context.Context, path string) ([]backend.Entry, error) {
+	return nil, backend.ErrNotExist
+}
+
+func (l *livePods) Stat(ctx context.Context, path string) (backend.Info, error) {
+	if _, ok := l.logs[strings.TrimPrefix(path, "/")]; !ok {
+		return backend.Info{}, backend.ErrNotExist
+	}
+
+	return backend.Info{Name: filepath.Base(path)}, nil
+}
+
+func (l *livePods) Open(ctx context.Context, path string) (io.ReadCloser, error) {
+	return l.Stream(ctx, path, backend.StreamOptions{})
+}
+
+func (l *livePods) Stream(
+	ctx context.Context,
+	path string,
+	opts backend.StreamOptions,
+) (io.ReadCloser, error) {
+	log, ok := l.logs[strings.TrimPrefix(path, "/")]
+	if !ok {
+		return nil, backend.ErrNotExist
+	}
+
+	var contents strings.Builder
+
+	for _, line := range log {
+		if opts.SinceTime != nil {
+			if lineTime, ok := lineTime([]byte(line)); ok && lineTime.Before(opts.SinceTime.Truncate(time.Second)) {
+				continue
+			}
+		}
+
+		contents.WriteString(line + "\n")
+	}
+
+	return io.NopCloser(strings.NewReader(contents.String())), nil
+}
+
+func (l *livePods) SelectPods(ctx context.Context, namespace string, selector string) ([]backend.Pod, error) {
+	return []backend.Pod{
+		{Namespace: namespace, Name: "pod-a", Containers: []string{"main"}},
+		{Namespace: namespace, Name: "pod-b", Containers: []string{"main"}},
+	}, nil
+}
+
+// readAllPodPages reads every page of the merged logs of the pods, following
+// the cursor of each page, and returns the contents of the lines.
+func readAllPodPages(t *testing.T, a *API) []string {
+	t.Helper()
+
+	var (
+		lines  []string
+		cursor *string
+	)
+
+	for page := 0; ; page++ {
+		response, err := a.GetPodsLogs(context.Background(), GetPodsLogsRequestObject{
+			Params: GetPodsLogsParams{
+				Namespace: "ns",
+				Selector:  "app=web",
+				Page:      &page,
+				Cursor:    cursor,
+			},
+		})
+		if err != nil {
+			t.Fatalf("GetPodsLogs: %v", err)
+		}
+
+		podsPage, ok := response.(GetPodsLogs200JSONResponse)
+		if !ok {
+			t.Fatalf("GetPodsLogs returned %#v", response)
+		}
+
+		for _, line := range podsPage.Lines {
+			contents, err := line.Contents.Bytes()
+			if err != nil {
+				t.Fatalf("reading contents: %v", err)
+			}
+
+			lines = append(lines, string(contents))
+		}
+
+		if podsPage.NextPage == nil {
+			return lines
+		}
+
+		if podsPage.NextCursor == nil {
+			t.Fatalf("page %d has a next page without a cursor", page)
+		}
+
+		cursor = podsPage.NextCursor
+
+		if page > 10 {
+			t.Fatal("the pages never ended")
+		}
+	}
+}
+
+// mergedOrder returns the lines of the logs ordered by time, with leading
+// lines without timestamps placed at the time of the first timestamp.
+func mergedOrder(logs ...[]string) []string {
+	type timedLine struct {
+		line string
+		time time.Time
+	}
+
+	var lines []timedLine
+
+	for _, log := range logs {
+		var leading []string
+
+		for _, line := range log {
+			lineTime, ok := lineTime([]byte(line))
+			if !ok {
+				leading = append(leading, line)
+
+				continue
+			}
+
+			for _, line := range leading {
+				lines = append(lines, timedLine{line, lineTime})
+			}
+
+			leading = nil
+
+			lines = append(lines, timedLine{line, lineTime})
+		}
+	}
+
+	slices.SortStableFunc(lines, func(a, b timedLine) int {
+		return a.time.Compare(b.time)
+	})
+
+	var ordered []string
+
+	for _, line := range lines {
+		ordered = append(ordered, line.line)
+	}
+
+	return ordered
+}
+
+func TestGetPodsLogsArchivedPages(t *testing.T) {
+	root := t.TempDir()
+
+	podA := podLog("pod-a", 70, 0, 2*time.Second, 3)
+	podB := podLog("pod-b", 60, time.Second, 2*time.Second, 0)
+
+	for pod, log := range map[string][]string{"pod-a": podA, "pod-b": podB} {
+		dir := filepath.Join(root, "ns", pod)
+
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		manifest := `{"metadata": {"labels": {"app": "web"}}}`
+
+		if err := os.WriteFile(filepath.Join(dir, backend.PodMetadataFile), []byte(manifest), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, "main"), []byte(strings.Join(log, "\n")+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a := newTestAPI(t, WithBackend(filesystem.New(root)))
+
+	lines := readAllPodPages(t, a)
+
+	if want := mergedOrder(podA, podB); !slices.Equal(lines, want) {
+		t.Errorf("merged lines:\n%s\nwant:\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+
+	// The leading lines without timestamps are placed at the time of the
+	// first line of their log, after the lines of earlier logs.
+	if lines[0] != "pod-a leading 0" {
+		t.Errorf("first line = %q, want the first leading line", lines[0])
+	}
+}
+
+func TestGetPodsLogsLivePages(t *testing.T) {
+	// Several lines are written within each second, so resuming from the
+	// second of the last line read repeats lines which must be skipped.
+	podA := podLog("pod-a", 80, 0, 300*time.Millisecond, 0)
+	podB := podLog("pod-b", 50, 100*time.Millisecond, 500*time.Millisecond, 0)
+
+	a := newTestAPI(t, WithBackend(&livePods{logs: map[string][]string{
+		"ns/pod-a/main": podA,
+		"ns/pod-b/main": podB,
+	}}))
+
+	lines := readAllPodPages(t, a)
+
+	if want := mergedOrder(podA, podB); !slices.Equal(lines, want) {
+		t.Errorf("merged lines:\n%s\nwant:\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestGetPodsLogsNamespace(t *testing.T) {
+	a := newTestAPI(t,
+		WithRoot("prod", &livePods{logs: map[string][]string{"ns/pod-a/main": {"line"}}}),
+		WithRoot("staging", &livePods{logs: map[string][]string{"ns/pod-a/main": {"line"}}}),
+	)
+
+	root := "staging"
+
+	// Namespaces which are not a single segment would select pods outside of
+	// the namespace, or the root, whose access was checked.
+	for _, namespace := range []string{"", ".", "..", "../prod/ns", "ns/pod-a", "/ns"} {
+		response, err := a.GetPodsLogs(context.Background(), GetPodsLogsRequestObject{
+			Params: GetPodsLogsParams{Root: &root, Namespace: namespace, Selector: "app=web"},
+		})
+		if err != nil {
+			t.Fatalf("GetPodsLogs(%q): %v", namespace, err)
+		}
+
+		if _, ok := response.(GetPodsLogs400JSONResponse); !ok {
+			t.Errorf("GetPodsLogs(%q) returned %#v, want a bad request", namespace, response)
+		}
+	}
+
+	response, err := a.GetPodsLogs(context.Background(), GetPodsLogsRequestObject{
+		Params: GetPodsLogsParams{Root: &root, Namespace: "ns", Selector: "app=web"},
+	})
+	if err != nil {
+		t.Fatalf("GetPodsLogs: %v", err)
+	}
+
+	if _, ok := response.(GetPodsLogs200JSONResponse); !ok {
+		t.Errorf("GetPodsLogs returned %#v, want the pods of the namespace", response)
+	}
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+
+	"github.com/crystalix007/log-viewer/authz"
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+	"github.com/crystalix007/log-viewer/redact"
+)
+
+// redactorFor returns the redactor to apply to logs shown to the user making
+// the request, or nil if they may view logs unredacted.
+func (a *API) redactorFor(ctx context.Context) *redact.Redactor {
+	if a.redactor == nil {
+		return nil
+	}
+
+	if a.policy != nil {
+		user, _ := kmiddleware.UserFromContext(ctx)
+
+		if a.policy.Permitted(user, authz.ViewUnredacted) {
+			return nil
+		}
+	}
+
+	return a.redactor
+}
+
+// redactLines masks the sensitive content of each line in place, reporting
+// whether anything was masked.
+func redactLines(redactor *redact.Redactor, lines [][]byte) bool {
+	if redactor == nil {
+		return false
+	}
+
+	var redacted bool
+
+	for i, line := range lines {
+		var matched bool
+
+		lines[i], matched = redactor.Redact(line)
+		redacted = redacted || matched
+	}
+
+	return redacted
+}
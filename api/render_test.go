@@ -0,0 +1,140 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+// hostileLines are log lines which would inject markup or script into a page
+// if rendered unescaped.
+var hostileLines = []string{
+	`<script>alert(1)</script>`,
+	`"><img src=x onerror=alert(1)>`,
+	`</pre><script>alert(2)</script><pre>`,
+	`javascript:alert(3)`,
+	`{"time":"2024-01-02T03:04:05Z","level":"<b>error</b>","msg":"</pre><script>alert(4)</script>","url":"javascript:alert(5)","\"><img src=x onerror=alert(6)>":"x"}`,
+	`{"level":"info\" onmouseover=\"alert(7)","msg":"x' onclick='alert(8)"}`,
+	"\x1b[31m<script>alert(9)</script>\x1b[0m",
+	"\x1b[38;2;1;2;\"><img src=x onerror=alert(10)>m red",
+	"\x1b]8;;javascript:alert(11)\x07link\x1b]8;;\x07",
+	"\x1b[999;<script>m\x1b[",
+	"\x1b[1m</span></pre><img src=x onerror=alert(12)>",
+}
+
+// renderedElements are the elements which the log page templates themselves
+// render. Any other element must have been injected by a log line.
+var renderedElements = []string{"pre", "span", "a", "details", "summary", "ul", "li", "button", "small", "strong", "em"}
+
+func TestRenderHostileLines(t *testing.T) {
+	a, _ := newFileAPI(t, map[string]string{"app.log": strings.Join(hostileLines, "\n") + "\n"})
+
+	views := map[string]url.Values{
+		"plain":      {"strip_ansi": {"true"}},
+		"structured": {"view": {"structured"}},
+		"ansi":       {"strip_ansi": {"false"}},
+		// The style carried over from the previous page is taken from the
+		// query, so is as untrusted as the lines.
+		"ansi state": {"strip_ansi": {"false"}, "ansi_state": {`31;"><script>alert(13)</script>`}},
+	}
+
+	for name, query := range views {
+		t.Run(name, func(t *testing.T) {
+			query.Set("path", "prod/app.log")
+
+			request := httptest.NewRequest(http.MethodGet, "/log/page?"+query.Encode(), nil)
+			request.Header.Set("Accept", "text/html")
+
+			recorder := httptest.NewRecorder()
+			a.ServeHTTP(recorder, request)
+
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("status = %d: %s", recorder.Code, recorder.Body)
+			}
+
+			assertEscaped(t, recorder.Body.String())
+		})
+	}
+}
+
+// assertEscaped checks that the page only contains the elements rendered by
+// the templates, without event handlers or script URLs, and that the lines are
+// all within the single preformatted block.
+func assertEscaped(t *testing.T, page string) {
+	t.Helper()
+
+	tokenizer := html.NewTokenizer(strings.NewReader(page))
+
+	var pres, spans int
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			if err := tokenizer.Err(); err != io.EOF {
+				t.Fatalf("tokenizing: %v", err)
+			}
+
+			break
+		}
+
+		if tokenType != html.StartTagToken && tokenType != html.EndTagToken && tokenType != html.SelfClosingTagToken {
+			continue
+		}
+
+		token := tokenizer.Token()
+
+		if !slices.Contains(renderedElements, token.Data) {
+			t.Errorf("injected element %s", token)
+		}
+
+		switch {
+		case token.Data == "pre" && tokenType == html.StartTagToken:
+			pres++
+		case token.Data == "span" && tokenType == html.StartTagToken:
+			spans++
+		case token.Data == "span" && tokenType == html.EndTagToken:
+			spans--
+		}
+
+		if spans < 0 {
+			t.Errorf("a line closed an element it did not open: %s", token)
+		}
+
+		for _, attr := range token.Attr {
+			if strings.HasPrefix(attr.Key, "on") {
+				t.Errorf("injected event handler %s=%q in %s", attr.Key, attr.Val, token)
+			}
+
+			value := strings.ToLower(strings.TrimSpace(attr.Val))
+
+			if (attr.Key == "href" || attr.Key == "src") && strings.HasPrefix(value, "javascript:") {
+				t.Errorf("injected script URL %s=%q in %s", attr.Key, attr.Val, token)
+			}
+
+			if attr.Key == "style" && (strings.Contains(value, "<") || strings.Contains(value, "url(")) {
+				t.Errorf("injected style %q in %s", attr.Val, token)
+			}
+		}
+	}
+
+	if pres != 1 || strings.Count(page, "</pre>") != 1 {
+		t.Errorf("page has %d preformatted blocks closed %d times, want one", pres, strings.Count(page, "</pre>"))
+	}
+
+	if spans != 0 {
+		t.Errorf("%d spans were left open", spans)
+	}
+
+	// Every line is shown, escaped, rather than being dropped.
+	for _, text := range []string{"alert(1)", "alert(3)", "alert(9)", "alert(12)"} {
+		if !strings.Contains(page, text) {
+			t.Errorf("the page does not show %q", text)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/crystalix007/log-viewer/backend"
+)
+
+// DefaultRoot is the name of the root serving the working directory, or the
+// backend configured with [WithBackend].
+const DefaultRoot = "default"
+
+var (
+	// ErrInvalidRootName is returned when a root's name is empty or contains a
+	// path separator.
+	ErrInvalidRootName = errors.New("api: invalid root name")
+
+	// ErrDuplicateRoot is returned when more than one root has the same name.
+	ErrDuplicateRoot = errors.New("api: duplicate root name")
+)
+
+// root is a named source of logs, addressed by the first segment of request
+// paths.
+type root struct {
+	name    string
+	backend backend.Backend
+}
+
+// GetRoots lists the roots that logs can be viewed from.
+func (a *API) GetRoots(
+	ctx context.Context,
+	request GetRootsRequestObject,
+) (GetRootsResponseObject, error) {
+	response := GetRoots200JSONResponse{
+		Roots: make([]Root, 0, len(a.roots)),
+	}
+
+	for _, root := range a.roots {
+		if !a.browsable(ctx, root.name) {
+			continue
+		}
+
+		response.Roots = append(response.Roots, Root{
+			Name: root.name,
+			Path: root.name,
+		})
+	}
+
+	return response, nil
+}
+
+// cleanPath returns the canonical form of a path within the API, relative to
+// the roots and without a leading slash, e.g. "prod/var/log1.log". The roots
+// themselves are listed at the empty path.
+//
+// Cleaning a rooted path removes any ".." components, so that a path can never
+// address a different root to the one named in its first segment.
+func cleanPath(requestPath string) string {
+	return strings.TrimPrefix(path.Clean("/"+requestPath), "/")
+}
+
+// resolvePath splits a request path into the backend of the root it
+// addresses, and the path within that root.
+func (a *API) resolvePath(requestPath string) (backend.Backend, string, error) {
+	rootName, rootPath, _ := strings.Cut(cleanPath(requestPath), "/")
+
+	b, ok := a.rootBackend(rootName)
+	if !ok {
+		return nil, "", backend.ErrNotExist
+	}
+
+	return b, "/" + rootPath, nil
+}
+
+// rootBackend returns the backend of the named root.
+func (a *API) rootBackend(name string) (backend.Backend, bool) {
+	for _, root := range a.roots {
+		if root.name == name {
+			return root.backend, true
+		}
+	}
+
+	return nil, false
+}
+
+// validateRoots ensures that every root has a unique, valid name.
+func (a *API) validateRoots() error {
+	names := make(map[string]struct{}, len(a.roots))
+
+	for _, root := range a.roots {
+		if root.name == "" || root.name == "." || root.name == ".." ||
+			strings.Contains(root.name, "/") {
+			return fmt.Errorf("%w: %q", ErrInvalidRootName, root.name)
+		}
+
+		if _, ok := names[root.name]; ok {
+			return fmt.Errorf("%w: %q", ErrDuplicateRoot, root.name)
+		}
+
+		names[root.name] = struct{}{}
+	}
+
+	return nil
+}
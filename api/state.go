@@ -0,0 +1,82 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/crystalix007/log-viewer/backend/filesystem"
+)
+
+// stateDirectoryName is the name of the viewer's directory within the user's
+// state directory.
+const stateDirectoryName = "log-viewer"
+
+// ErrStateDirectoryInRoot is returned when the state directory is within the
+// directory of a root, so that its files would be served as logs.
+var ErrStateDirectoryInRoot = errors.New("api: state directory is within a root")
+
+// DefaultStateDirectory returns the directory that the state of the viewer is
+// stored in by default, following the XDG base directory specification:
+// "$XDG_STATE_HOME/log-viewer", or "~/.local/state/log-viewer".
+func DefaultStateDirectory() (string, error) {
+	if stateHome := os.Getenv("XDG_STATE_HOME"); filepath.IsAbs(stateHome) {
+		return filepath.Join(stateHome, stateDirectoryName), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("api: finding the default state directory: %w", err)
+	}
+
+	return filepath.Join(home, ".local", "state", stateDirectoryName), nil
+}
+
+// validateStateDirectory ensures that the state directory is not within the
+// directory of any filesystem root.
+func (a *API) validateStateDirectory() error {
+	stateDirectory := resolveLocalPath(a.stateDirectory)
+
+	for _, root := range a.roots {
+		filesystemBackend, ok := root.backend.(*filesystem.Backend)
+		if !ok {
+			continue
+		}
+
+		rel, err := filepath.Rel(resolveLocalPath(filesystemBackend.Root()), stateDirectory)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		return fmt.Errorf("%w: %q is within root %q", ErrStateDirectoryInRoot, a.stateDirectory, root.name)
+	}
+
+	return nil
+}
+
+// resolveLocalPath returns the absolute path of a local file or directory,
+// with any symbolic links resolved, so that it can be compared with the
+// directories of roots. Symbolic links are resolved in as much of the path as
+// exists.
+func resolveLocalPath(localPath string) string {
+	absolute, err := filepath.Abs(localPath)
+	if err != nil {
+		return filepath.Clean(localPath)
+	}
+
+	var missing []string
+
+	for existing := absolute; ; existing = filepath.Dir(existing) {
+		if resolved, err := filepath.EvalSymlinks(existing); err == nil {
+			return filepath.Join(append([]string{resolved}, missing...)...)
+		}
+
+		if filepath.Dir(existing) == existing {
+			return absolute
+		}
+
+		missing = append([]string{filepath.Base(existing)}, missing...)
+	}
+}
@@ -0,0 +1,206 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/crystalix007/log-viewer/backend"
+	"github.com/crystalix007/log-viewer/structured"
+)
+
+// maxCachedStats is the number of logs whose statistics are cached, beyond
+// which those of the least recently viewed logs are evicted.
+const maxCachedStats = 256
+
+// liveStatsLifetime is how long the statistics of a live log are reused for,
+// as its identity does not change as lines are written.
+const liveStatsLifetime = time.Minute
+
+// logStats are the statistics of the lines of a log.
+type logStats struct {
+	lines int
+
+	// first and last are the times of the first and last lines with a
+	// timestamp.
+	first *time.Time
+	last  *time.Time
+
+	// format is the format of most of the lines, and levels the number of
+	// lines logged at each normalised level.
+	format structured.Format
+	levels map[string]int
+}
+
+// statsCache holds the statistics of the most recently viewed logs, by their
+// paths, alongside the identity of the contents that they were computed from.
+type statsCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+
+	// recent orders the entries from the most to the least recently used.
+	recent list.List
+}
+
+type cachedStats struct {
+	logPath  string
+	identity string
+	stats    logStats
+
+	// expires is when the statistics must be recomputed, even if the
+	// identity of the contents is unchanged, if set.
+	expires time.Time
+}
+
+// get returns the statistics of the log at the given path, if they were
+// computed from the contents with the given identity and have not expired.
+func (c *statsCache) get(logPath string, identity string) (logStats, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[logPath]
+	if !ok {
+		return logStats{}, false
+	}
+
+	entry := element.Value.(cachedStats)
+	if entry.identity != identity || (!entry.expires.IsZero() && time.Now().After(entry.expires)) {
+		return logStats{}, false
+	}
+
+	c.recent.MoveToFront(element)
+
+	return entry.stats, true
+}
+
+// put stores the statistics of the log at the given path until they expire,
+// or indefinitely if expires is zero. They replace those of any previous
+// contents, and those of the least recently used log are evicted if the cache
+// is full.
+func (c *statsCache) put(logPath string, identity string, stats logStats, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+	}
+
+	entry := cachedStats{
+		logPath:  logPath,
+		identity: identity,
+		stats:    stats,
+		expires:  expires,
+	}
+
+	if element, ok := c.entries[logPath]; ok {
+		element.Value = entry
+		c.recent.MoveToFront(element)
+
+		return
+	}
+
+	c.entries[logPath] = c.recent.PushFront(entry)
+
+	if c.recent.Len() > maxCachedStats {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.entries, oldest.Value.(cachedStats).logPath)
+	}
+}
+
+// logStats returns the statistics of the log at the given path, only reading
+// it if it has changed since they were last computed.
+//
+// The identity of a live log does not change as lines are written, so its
+// statistics are instead reused for up to [liveStatsLifetime].
+func (a *API) logStats(
+	ctx context.Context,
+	b backend.Backend,
+	logPath string,
+	info backend.Info,
+) (logStats, error) {
+	logPath = cleanPath(logPath)
+	identity := fileIdentity(info)
+
+	var expires time.Time
+	if _, live := b.(backend.Streamer); live {
+		expires = time.Now().Add(liveStatsLifetime)
+	}
+
+	if stats, ok := a.stats.get(logPath, identity); ok {
+		return stats, nil
+	}
+
+	file, err := a.open(ctx, logPath, backend.StreamOptions{})
+	if err != nil {
+		return logStats{}, err
+	}
+
+	defer file.Close()
+
+	stats, err := readStats(file)
+	if err != nil {
+		return logStats{}, err
+	}
+
+	a.stats.put(logPath, identity, stats, expires)
+
+	return stats, nil
+}
+
+// readStats reads every line of a log, computing its statistics.
+func readStats(r io.Reader) (logStats, error) {
+	reader := bufio.NewReader(r)
+
+	stats := logStats{
+		format: structured.FormatText,
+		levels: make(map[string]int),
+	}
+
+	formats := make(map[structured.Format]int)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) == 0 && errors.Is(err, io.EOF) {
+			break
+		} else if err != nil && !errors.Is(err, io.EOF) {
+			return logStats{}, err
+		}
+
+		line = bytes.TrimSuffix(line, []byte("\n"))
+		stats.lines++
+
+		if t, ok := lineTime(line); ok {
+			if stats.first == nil {
+				stats.first = &t
+			}
+
+			stats.last = &t
+		}
+
+		if level := structured.DetectLevel(line); level != "" {
+			stats.levels[level]++
+		}
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			formats[structured.DetectFormat(line)]++
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+
+	for _, format := range []structured.Format{structured.FormatJSON, structured.FormatLogfmt} {
+		if formats[format] > formats[stats.format] {
+			stats.format = format
+		}
+	}
+
+	return stats, nil
+}
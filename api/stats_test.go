@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStatsCacheBounded(t *testing.T) {
+	var cache statsCache
+
+	for i := range maxCachedStats {
+		cache.put(fmt.Sprint(i), "identity", logStats{lines: i}, time.Time{})
+	}
+
+	// Viewing the oldest log keeps it cached, so the next oldest is evicted
+	// in its place.
+	if _, ok := cache.get("0", "identity"); !ok {
+		t.Fatal("the oldest log is not cached")
+	}
+
+	cache.put("new", "identity", logStats{}, time.Time{})
+
+	if len(cache.entries) != maxCachedStats || cache.recent.Len() != maxCachedStats {
+		t.Errorf("%d logs are cached, want %d", len(cache.entries), maxCachedStats)
+	}
+
+	if _, ok := cache.get("1", "identity"); ok {
+		t.Error("the least recently viewed log was not evicted")
+	}
+
+	for _, logPath := range []string{"0", "2", "new"} {
+		if _, ok := cache.get(logPath, "identity"); !ok {
+			t.Errorf("the log %q was evicted", logPath)
+		}
+	}
+
+	if _, ok := cache.get("0", "changed"); ok {
+		t.Error("the statistics of changed contents were returned")
+	}
+
+	cache.put("live", "identity", logStats{}, time.Now().Add(-time.Second))
+
+	if _, ok := cache.get("live", "identity"); ok {
+		t.Error("expired statistics were returned")
+	}
+}
+
+func TestGetLogLiveStats(t *testing.T) {
+	pods := &livePods{logs: map[string][]string{
+		"ns/pod/main": {`{"level":"info","msg":"one"}`, `{"level":"error","msg":"two"}`},
+	}}
+
+	a := newTestAPI(t, WithBackend(pods))
+
+	getStats := func() GetLog200JSONResponse {
+		t.Helper()
+
+		response, err := a.GetLog(context.Background(), GetLogRequestObject{
+			Params: GetLogParams{Path: DefaultRoot + "/ns/pod/main"},
+		})
+		if err != nil {
+			t.Fatalf("GetLog: %v", err)
+		}
+
+		stats, ok := response.(GetLog200JSONResponse)
+		if !ok {
+			t.Fatalf("GetLog returned %#v", response)
+		}
+
+		return stats
+	}
+
+	stats := getStats()
+	if stats.LineCount != 2 || stats.Format != LogFormatJson || stats.Levels["error"] != 1 {
+		t.Fatalf("GetLog returned %+v", stats)
+	}
+
+	// The live log is not read again while its statistics are fresh.
+	pods.logs["ns/pod/main"] = append(pods.logs["ns/pod/main"], "three")
+
+	if stats := getStats(); stats.LineCount != 2 {
+		t.Errorf("GetLog counted %d lines, want the cached 2", stats.LineCount)
+	}
+}
+
+func TestGetLogBeingWritten(t *testing.T) {
+	a, dir := newFileAPI(t, map[string]string{"app.log": "line\n"})
+	logPath := filepath.Join(dir, "app.log")
+
+	beingWritten := func() bool {
+		t.Helper()
+
+		response, err := a.GetLog(context.Background(), GetLogRequestObject{
+			Params: GetLogParams{Path: "prod/app.log"},
+		})
+		if err != nil {
+			t.Fatalf("GetLog: %v", err)
+		}
+
+		return response.(GetLog200JSONResponse).BeingWritten
+	}
+
+	if !beingWritten() {
+		t.Error("a file which was just written is not being written")
+	}
+
+	if err := os.Chtimes(logPath, time.Time{}, time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if beingWritten() {
+		t.Error("a file last written an hour ago is still being written")
+	}
+}
+
+func TestGetLogFileStats(t *testing.T) {
+	a, dir := newFileAPI(t, map[string]string{
+		"app.log": "time=2024-01-02T03:04:05Z level=info msg=a\ntime=2024-01-02T03:04:06Z level=warn msg=b\n",
+	})
+
+	getLog := func() GetLog200JSONResponse {
+		t.Helper()
+
+		response, err := a.GetLog(context.Background(), GetLogRequestObject{
+			Params: GetLogParams{Path: "prod/app.log"},
+		})
+		if err != nil {
+			t.Fatalf("GetLog: %v", err)
+		}
+
+		return response.(GetLog200JSONResponse)
+	}
+
+	details := getLog()
+	if details.LineCount != 2 || details.Format != LogFormatLogfmt || details.Levels["warn"] != 1 ||
+		details.LastTime == nil || !details.LastTime.Equal(time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC)) {
+		t.Fatalf("GetLog returned %+v", details)
+	}
+
+	// Changing the file changes its identity, so the statistics are computed
+	// again.
+	file, err := os.OpenFile(filepath.Join(dir, "app.log"), os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := file.WriteString("time=2024-01-02T03:04:07Z level=error msg=c\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	file.Close()
+
+	if details := getLog(); details.LineCount != 3 || details.Levels["error"] != 1 {
+		t.Errorf("GetLog returned %+v after the log changed, want three lines", details)
+	}
+}
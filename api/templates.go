@@ -1,17 +1,30 @@
 package api
 
 import (
+	"context"
 	"embed"
 	"errors"
 	"fmt"
+	"io/fs"
+	"os"
 	"path"
+	"time"
+
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
 )
 
 //go:embed templates/*
 var templates embed.FS
 
-// ErrNoTemplateFound is returned when no templates are found.
-var ErrNoTemplateFound = errors.New("api: no templates found")
+var (
+	// ErrNoTemplateFound is returned when no templates are found.
+	ErrNoTemplateFound = errors.New("api: no templates found")
+
+	// ErrReloadWithoutTemplateDirectory is returned when templates are to be
+	// reloaded without a template directory, as the embedded templates never
+	// change.
+	ErrReloadWithoutTemplateDirectory = errors.New("api: reloading templates requires a template directory")
+)
 
 // GetTemplate reads a template from the templates directory.
 func GetTemplate(name string) ([]byte, error) {
@@ -40,3 +53,62 @@ func GetFirstTemplate(names ...string) ([]byte, error) {
 
 	return nil, ErrNoTemplateFound
 }
+
+// templateReloadInterval is how often the template directory is checked for
+// changes, when templates are reloaded.
+const templateReloadInterval = time.Second
+
+// templateProvider loads the templates that pages are rendered with: the
+// embedded templates, overlaid by those in the template directory if
+// configured, and reloaded when changed if configured.
+func (a *API) templateProvider() (kmiddleware.TemplateProvider, error) {
+	var (
+		source  kmiddleware.TemplateSource = templates
+		rootDir                            = "templates"
+	)
+
+	if a.templateDirectory != "" {
+		embeddedTemplates, err := fs.Sub(templates, rootDir)
+		if err != nil {
+			return nil, fmt.Errorf("api: loading templates: %w", err)
+		}
+
+		source = kmiddleware.NewOverlayFS(
+			os.DirFS(a.templateDirectory).(kmiddleware.TemplateSource),
+			embeddedTemplates.(kmiddleware.TemplateSource),
+		)
+		rootDir = "."
+	}
+
+	if a.reloadTemplates {
+		if a.templateDirectory == "" {
+			return nil, ErrReloadWithoutTemplateDirectory
+		}
+
+		// The directory is watched until the API is closed.
+		ctx, cancel := context.WithCancel(context.Background())
+
+		reloadingTemplates, err := kmiddleware.WatchTemplates(
+			ctx,
+			source,
+			rootDir,
+			templateReloadInterval,
+		)
+		if err != nil {
+			cancel()
+
+			return nil, fmt.Errorf("api: loading templates: %w", err)
+		}
+
+		a.stopTemplates = cancel
+
+		return reloadingTemplates, nil
+	}
+
+	loadedTemplates, err := kmiddleware.NewTemplates(source, rootDir)
+	if err != nil {
+		return nil, fmt.Errorf("api: loading templates: %w", err)
+	}
+
+	return loadedTemplates, nil
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTemplateReloadRequiresDirectory(t *testing.T) {
+	_, err := New(
+		WithTemplateReload(),
+		WithStateDirectory(t.TempDir()),
+	)
+	if !errors.Is(err, ErrReloadWithoutTemplateDirectory) {
+		t.Errorf("New reloading the embedded templates returned %v, want %v", err, ErrReloadWithoutTemplateDirectory)
+	}
+
+	a, err := New(
+		WithTemplateDirectory(t.TempDir()),
+		WithTemplateReload(),
+		WithStateDirectory(t.TempDir()),
+	)
+	if err != nil {
+		t.Fatalf("New reloading the template directory returned %v", err)
+	}
+
+	a.Close()
+}
+
+func TestCloseStopsTemplateReload(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "roots", "index.tmpl.txt")
+
+	writeTemplate := func(contents string) {
+		t.Helper()
+
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(name, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeTemplate("first")
+
+	a := newTestAPI(t, WithTemplateDirectory(dir), WithTemplateReload())
+
+	rootsPage := func() string {
+		t.Helper()
+
+		request := httptest.NewRequest(http.MethodGet, "/roots", nil)
+		request.Header.Set("Accept", "text/plain")
+
+		recorder := httptest.NewRecorder()
+		a.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("status = %d: %s", recorder.Code, recorder.Body)
+		}
+
+		return recorder.Body.String()
+	}
+
+	if page := rootsPage(); page != "first" {
+		t.Fatalf("rendered %q, want the template in the directory", page)
+	}
+
+	// Each template differs in size, so that changes are seen even if the
+	// modification time is unchanged.
+	writeTemplate("second!")
+
+	for deadline := time.Now().Add(5 * templateReloadInterval); rootsPage() != "second!"; {
+		if time.Now().After(deadline) {
+			t.Fatal("the changed template was not reloaded")
+		}
+
+		time.Sleep(templateReloadInterval / 10)
+	}
+
+	a.Close()
+
+	writeTemplate("third, after closing")
+	time.Sleep(2 * templateReloadInterval)
+
+	if page := rootsPage(); page != "second!" {
+		t.Errorf("rendered %q after closing, want the templates no longer reloaded", page)
+	}
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// lineTime extracts the time that a log line was written.
+//
+// Lines prefixed with an RFC 3339 timestamp (as written by the container
+// runtime, or `kubectl logs --timestamps`), slog text lines ("time=...") and
+// JSON lines with a "time" field are supported.
+func lineTime(line []byte) (time.Time, bool) {
+	if bytes.HasPrefix(line, []byte("{")) {
+		var record struct {
+			Time time.Time `json:"time"`
+		}
+
+		if err := json.Unmarshal(line, &record); err != nil || record.Time.IsZero() {
+			return time.Time{}, false
+		}
+
+		return record.Time, true
+	}
+
+	field, _, _ := bytes.Cut(line, []byte(" "))
+	field = bytes.TrimPrefix(field, []byte("time="))
+
+	lineTime, err := time.Parse(time.RFC3339Nano, string(field))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return lineTime, true
+}
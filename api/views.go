@@ -0,0 +1,223 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	kmiddleware "github.com/crystalix007/log-viewer/middleware"
+	"github.com/crystalix007/log-viewer/store"
+)
+
+// viewsCollection is the name of the collection holding saved views, within
+// the state directory.
+const viewsCollection = "views"
+
+func (a *API) GetViews(
+	ctx context.Context,
+	request GetViewsRequestObject,
+) (GetViewsResponseObject, error) {
+	views := []View{}
+
+	for _, view := range a.views.List() {
+		if request.Params.Id != nil && view.Id != *request.Params.Id {
+			continue
+		}
+
+		if a.viewAllowed(ctx, view) {
+			views = append(views, view)
+		}
+	}
+
+	if request.Params.Id != nil && len(views) == 0 {
+		return GetViews404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified view does not exist",
+		}, nil
+	}
+
+	slices.SortFunc(views, func(a, b View) int {
+		return b.Created.Compare(a.Created)
+	})
+
+	return GetViews200JSONResponse{
+		Views: views,
+	}, nil
+}
+
+func (a *API) PostViews(
+	ctx context.Context,
+	request PostViewsRequestObject,
+) (PostViewsResponseObject, error) {
+	logPaths := make([]string, len(request.Body.Paths))
+
+	for i, logPath := range request.Body.Paths {
+		logPaths[i] = cleanPath(logPath)
+	}
+
+	if request.Body.Name == "" || len(logPaths) == 0 || slices.Contains(logPaths, "") {
+		return PostViews400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: "Requires a name and at least one non-empty log path",
+		}, nil
+	}
+
+	// The filters are validated as they would be when the view is restored.
+	if _, err := newLineFilter(
+		request.Body.Fields,
+		request.Body.Search,
+		request.Body.Levels,
+		request.Body.SinceTime,
+		request.Body.UntilTime,
+	); err != nil {
+		return PostViews400JSONResponse{
+			Code:    ErrorCodeInvalidRequest,
+			Message: err.Error(),
+		}, nil
+	}
+
+	for _, logPath := range logPaths {
+		if !a.allowed(ctx, logPath) {
+			return PostViews403JSONResponse{
+				Code:    ErrorCodeForbidden,
+				Message: accessDenied,
+			}, nil
+		}
+	}
+
+	user, _ := kmiddleware.UserFromContext(ctx)
+
+	view := View{
+		Id:         store.NewID(),
+		Name:       request.Body.Name,
+		Paths:      logPaths,
+		Search:     request.Body.Search,
+		Levels:     request.Body.Levels,
+		Fields:     request.Body.Fields,
+		SinceTime:  request.Body.SinceTime,
+		UntilTime:  request.Body.UntilTime,
+		Structured: request.Body.Structured != nil && *request.Body.Structured,
+		StripAnsi:  request.Body.StripAnsi != nil && *request.Body.StripAnsi,
+		Author:     user.Name,
+		Created:    time.Now().UTC(),
+	}
+
+	view.Url = "/v/" + view.Id
+
+	for _, logPath := range view.Paths {
+		view.Links = append(view.Links, ViewLink{
+			Path: logPath,
+			Url:  view.restoreURL(logPath),
+		})
+	}
+
+	if err := a.views.Put(view.Id, view); err != nil {
+		return nil, err
+	}
+
+	return PostViews201JSONResponse(view), nil
+}
+
+func (a *API) DeleteViewsId(
+	ctx context.Context,
+	request DeleteViewsIdRequestObject,
+) (DeleteViewsIdResponseObject, error) {
+	view, err := a.views.Get(request.Id)
+	if errors.Is(err, store.ErrNotFound) || (err == nil && !a.viewAllowed(ctx, view)) {
+		return DeleteViewsId404JSONResponse{
+			Code:    ErrorCodeNotFound,
+			Message: "The specified view does not exist",
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	user, _ := kmiddleware.UserFromContext(ctx)
+
+	if user.Name != view.Author {
+		return DeleteViewsId403JSONResponse{
+			Code:    ErrorCodeForbidden,
+			Message: "Only the author of a view may delete it",
+		}, nil
+	}
+
+	if err := a.views.Delete(view.Id); err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	}
+
+	return DeleteViewsId204Response{}, nil
+}
+
+// RestoreView redirects the short URL of a saved view to the view of its log,
+// or, for a view of several logs, to the list of the links restoring the view
+// of each.
+func (a *API) RestoreView(w http.ResponseWriter, r *http.Request) {
+	view, err := a.views.Get(chi.URLParam(r, "id"))
+	if err != nil || len(view.Paths) == 0 || !a.viewAllowed(r.Context(), view) {
+		kmiddleware.RenderError(w, r, a.templates, http.StatusNotFound, "The specified view does not exist")
+
+		return
+	}
+
+	if len(view.Paths) > 1 {
+		http.Redirect(w, r, "/views?"+url.Values{"id": {view.Id}}.Encode(), http.StatusFound)
+
+		return
+	}
+
+	http.Redirect(w, r, view.restoreURL(view.Paths[0]), http.StatusFound)
+}
+
+// viewAllowed reports whether the user making the request may view all of the
+// logs of a view.
+func (a *API) viewAllowed(ctx context.Context, view View) bool {
+	for _, logPath := range view.Paths {
+		if !a.allowed(ctx, logPath) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// restoreURL returns the URL of the page showing the log at the given path,
+// with the filters and display options of the view.
+func (v View) restoreURL(logPath string) string {
+	query := url.Values{}
+	query.Set("path", logPath)
+
+	if v.Search != nil && *v.Search != "" {
+		query.Set("search", *v.Search)
+	}
+
+	if v.Levels != nil {
+		query["level"] = *v.Levels
+	}
+
+	if v.Fields != nil {
+		query["field"] = *v.Fields
+	}
+
+	if v.SinceTime != nil {
+		query.Set("since_time", v.SinceTime.Format(time.RFC3339Nano))
+	}
+
+	if v.UntilTime != nil {
+		query.Set("until_time", v.UntilTime.Format(time.RFC3339Nano))
+	}
+
+	if v.Structured {
+		query.Set("view", "structured")
+	}
+
+	if v.StripAnsi {
+		query.Set("strip_ansi", "true")
+	}
+
+	return "/log?" + query.Encode()
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPostViewsPaths(t *testing.T) {
+	a, _ := newFileAPI(t, map[string]string{"app.log": "line\n", "other.log": "line\n"})
+
+	for _, paths := range [][]string{nil, {""}, {"/"}, {"prod/app.log", "/"}} {
+		response, err := a.PostViews(context.Background(), PostViewsRequestObject{
+			Body: &PostViewsJSONRequestBody{Name: "errors", Paths: paths},
+		})
+		if err != nil {
+			t.Fatalf("PostViews(%q): %v", paths, err)
+		}
+
+		if _, ok := response.(PostViews400JSONResponse); !ok {
+			t.Errorf("PostViews(%q) returned %#v, want a bad request", paths, response)
+		}
+	}
+
+	view := postView(t, a, "errors", "/prod//app.log")
+
+	if len(view.Paths) != 1 || view.Paths[0] != "prod/app.log" {
+		t.Errorf("view saved with the paths %q, want the canonical path", view.Paths)
+	}
+
+	if location := restoreView(t, a, view); location != "/log?path=prod%2Fapp.log" {
+		t.Errorf("the view of a log was restored to %q, want the log", location)
+	}
+}
+
+func TestRestoreViewOfLogs(t *testing.T) {
+	a, _ := newFileAPI(t, map[string]string{"app.log": "line\n", "other.log": "line\n"})
+
+	postView(t, a, "app", "prod/app.log")
+	view := postView(t, a, "logs", "prod/app.log", "prod/other.log")
+
+	if len(view.Links) != 2 || view.Links[1].Url != "/log?path=prod%2Fother.log" {
+		t.Errorf("view saved with the links %+v, want one for each log", view.Links)
+	}
+
+	// A view of several logs is restored to the list of the links restoring
+	// each of them.
+	location := restoreView(t, a, view)
+	if location != "/views?id="+view.Id {
+		t.Fatalf("the view of several logs was restored to %q, want its links", location)
+	}
+
+	request := httptest.NewRequest(http.MethodGet, location, nil)
+	request.Header.Set("Accept", "text/plain")
+
+	recorder := httptest.NewRecorder()
+	a.ServeHTTP(recorder, request)
+
+	want := "logs " + view.Url + "\n" +
+		"  prod/app.log /log?path=prod%2Fapp.log\n" +
+		"  prod/other.log /log?path=prod%2Fother.log\n"
+
+	if recorder.Code != http.StatusOK || recorder.Body.String() != want {
+		t.Errorf("GET %s = %d:\n%s\nwant only the view's links:\n%s", location, recorder.Code, recorder.Body, want)
+	}
+
+	missing := "missing"
+
+	response, err := a.GetViews(context.Background(), GetViewsRequestObject{
+		Params: GetViewsParams{Id: &missing},
+	})
+	if err != nil {
+		t.Fatalf("GetViews: %v", err)
+	}
+
+	if _, ok := response.(GetViews404JSONResponse); !ok {
+		t.Errorf("GetViews of a missing view returned %#v, want not found", response)
+	}
+}
+
+// postView saves a view of the logs at the given paths.
+func postView(t *testing.T, a *API, name string, paths ...string) View {
+	t.Helper()
+
+	response, err := a.PostViews(context.Background(), PostViewsRequestObject{
+		Body: &PostViewsJSONRequestBody{Name: name, Paths: paths},
+	})
+	if err != nil {
+		t.Fatalf("PostViews: %v", err)
+	}
+
+	view, ok := response.(PostViews201JSONResponse)
+	if !ok {
+		t.Fatalf("PostViews returned %#v", response)
+	}
+
+	return View(view)
+}
+
+// restoreView requests the short URL of the view, returning the URL that it
+// redirects to.
+func restoreView(t *testing.T, a *API, view View) string {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	a.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, view.Url, nil))
+
+	if recorder.Code != http.StatusFound {
+		t.Fatalf("GET %s = %d, want a redirect", view.Url, recorder.Code)
+	}
+
+	return recorder.Header().Get("Location")
+}
@@ -0,0 +1,109 @@
+// Package audit records who viewed which logs, for compliance.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Record is a single access to log content.
+type Record struct {
+	Time     time.Time `json:"time"`
+	User     string    `json:"user"`
+	Groups   []string  `json:"groups,omitempty"`
+	Endpoint string    `json:"endpoint"`
+	Path     string    `json:"path,omitempty"`
+	Query    string    `json:"query,omitempty"`
+
+	// Range is the byte range requested, if any, and Bytes the number of
+	// bytes served.
+	Range string `json:"range,omitempty"`
+	Bytes int64  `json:"bytes"`
+
+	Status int `json:"status"`
+}
+
+// Log writes audit records as JSON lines, retaining the most recent records in
+// memory so that they can be reviewed.
+type Log struct {
+	mu      sync.Mutex
+	writer  io.Writer
+	encoder *json.Encoder
+
+	// recent is a ring buffer of the most recent records, of which next is
+	// the oldest once the buffer is full.
+	recent []Record
+	next   int
+	full   bool
+}
+
+// New creates an audit log writing to the given writer, retaining the given
+// number of recent records in memory.
+func New(w io.Writer, retain int) *Log {
+	return &Log{
+		writer:  w,
+		encoder: json.NewEncoder(w),
+		recent:  make([]Record, retain),
+	}
+}
+
+// Write appends the record to the log.
+func (l *Log) Write(record Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.recent) > 0 {
+		l.recent[l.next] = record
+		l.next = (l.next + 1) % len(l.recent)
+		l.full = l.full || l.next == 0
+	}
+
+	if err := l.encoder.Encode(record); err != nil {
+		return fmt.Errorf("audit: writing record: %w", err)
+	}
+
+	return nil
+}
+
+// Retains reports whether recent records are retained in memory.
+func (l *Log) Retains() bool {
+	return len(l.recent) > 0
+}
+
+// Recent returns up to limit of the most recent records, newest first.
+func (l *Log) Recent(limit int) []Record {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var records []Record
+
+	if l.full {
+		records = slices.Concat(l.recent[l.next:], l.recent[:l.next])
+	} else {
+		records = slices.Clone(l.recent[:l.next])
+	}
+
+	slices.Reverse(records)
+
+	if limit >= 0 && len(records) > limit {
+		records = records[:limit]
+	}
+
+	return records
+}
+
+// Close closes the underlying writer, if it can be closed.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if closer, ok := l.writer.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
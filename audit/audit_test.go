@@ -0,0 +1,174 @@
+package audit_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/crystalix007/log-viewer/audit"
+)
+
+// paths returns the paths of the records, in order.
+func paths(records []audit.Record) []string {
+	paths := make([]string, 0, len(records))
+
+	for _, record := range records {
+		paths = append(paths, record.Path)
+	}
+
+	return paths
+}
+
+func TestRecent(t *testing.T) {
+	tests := []struct {
+		name   string
+		retain int
+		writes int
+		limit  int
+		want   []string
+	}{
+		{
+			name:   "partially filled",
+			retain: 3,
+			writes: 2,
+			limit:  -1,
+			want:   []string{"log-2", "log-1"},
+		},
+		{
+			name:   "exactly filled",
+			retain: 3,
+			writes: 3,
+			limit:  -1,
+			want:   []string{"log-3", "log-2", "log-1"},
+		},
+		{
+			name:   "wrapped around",
+			retain: 3,
+			writes: 7,
+			limit:  -1,
+			want:   []string{"log-7", "log-6", "log-5"},
+		},
+		{
+			name:   "limited",
+			retain: 3,
+			writes: 7,
+			limit:  2,
+			want:   []string{"log-7", "log-6"},
+		},
+		{
+			name:   "nothing written",
+			retain: 3,
+			limit:  -1,
+			want:   []string{},
+		},
+		{
+			name:   "nothing retained",
+			writes: 3,
+			limit:  -1,
+			want:   []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			log := audit.New(io.Discard, test.retain)
+
+			if log.Retains() != (test.retain > 0) {
+				t.Errorf("Retains() = %t, want %t", log.Retains(), test.retain > 0)
+			}
+
+			for i := range test.writes {
+				if err := log.Write(audit.Record{Path: "log-" + strconv.Itoa(i+1)}); err != nil {
+					t.Fatalf("Write: %v", err)
+				}
+			}
+
+			if got := paths(log.Recent(test.limit)); !slices.Equal(got, test.want) {
+				t.Errorf("Recent(%d) = %q, want %q", test.limit, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRotatedLog(t *testing.T) {
+	dir := t.TempDir()
+
+	// Each record is over 100 bytes, so every other record rotates the
+	// file.
+	f, err := audit.OpenRotatingFile(filepath.Join(dir, "audit.log"), 250, 2)
+	if err != nil {
+		t.Fatalf("OpenRotatingFile: %v", err)
+	}
+
+	log := audit.New(f, 2)
+
+	for i := range 8 {
+		record := audit.Record{
+			User:     "alice",
+			Endpoint: "GET /api/log",
+			Path:     "prod/app-" + strconv.Itoa(i+1) + ".log",
+			Status:   200,
+		}
+
+		if err := log.Write(record); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+
+	if err := log.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// The oldest backups are pruned, and each file holds whole records.
+	want := map[string][]string{
+		"audit.log":   {"prod/app-7.log", "prod/app-8.log"},
+		"audit.log.1": {"prod/app-5.log", "prod/app-6.log"},
+		"audit.log.2": {"prod/app-3.log", "prod/app-4.log"},
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != len(want) {
+		t.Errorf("wrote %d files, want the log and %d backups", len(entries), len(want)-1)
+	}
+
+	for name, wantPaths := range want {
+		file, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("opening %s: %v", name, err)
+
+			continue
+		}
+
+		var records []audit.Record
+
+		for scanner := bufio.NewScanner(file); scanner.Scan(); {
+			var record audit.Record
+
+			if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+				t.Errorf("%s holds an invalid record %q: %v", name, scanner.Text(), err)
+			}
+
+			records = append(records, record)
+		}
+
+		file.Close()
+
+		if got := paths(records); !slices.Equal(got, wantPaths) {
+			t.Errorf("%s holds %q, want %q", name, got, wantPaths)
+		}
+	}
+
+	// The retained records are unaffected by rotation.
+	if got, want := paths(log.Recent(-1)), []string{"prod/app-8.log", "prod/app-7.log"}; !slices.Equal(got, want) {
+		t.Errorf("Recent = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+)
+
+// RotatingFile is an append-only file which is rotated once it reaches a
+// maximum size, keeping a number of previous files as "<name>.1", "<name>.2",
+// and so on, from newest to oldest.
+type RotatingFile struct {
+	mu         sync.Mutex
+	filename   string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// OpenRotatingFile opens the file for appending, rotating it once it exceeds
+// maxSize bytes. A maxSize of zero disables rotation.
+func OpenRotatingFile(filename string, maxSize int64, maxBackups int) (*RotatingFile, error) {
+	f := &RotatingFile{
+		filename:   filename,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Write appends to the file, first rotating it if the write would exceed the
+// maximum size.
+func (f *RotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+
+	if err != nil {
+		return n, fmt.Errorf("audit: writing %s: %w", f.filename, err)
+	}
+
+	return n, nil
+}
+
+// Close closes the file.
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Close()
+}
+
+// open opens the file for appending, creating it if necessary.
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("audit: opening %s: %w", f.filename, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+
+		return fmt.Errorf("audit: getting size of %s: %w", f.filename, err)
+	}
+
+	f.file = file
+	f.size = info.Size()
+
+	return nil
+}
+
+// rotate renames the current file and its backups, discarding the oldest, and
+// opens a new file.
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("audit: closing %s: %w", f.filename, err)
+	}
+
+	if f.maxBackups > 0 {
+		for i := f.maxBackups - 1; i > 0; i-- {
+			err := os.Rename(f.backup(i), f.backup(i+1))
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("audit: rotating %s: %w", f.filename, err)
+			}
+		}
+
+		if err := os.Rename(f.filename, f.backup(1)); err != nil {
+			return fmt.Errorf("audit: rotating %s: %w", f.filename, err)
+		}
+	} else if err := os.Remove(f.filename); err != nil {
+		return fmt.Errorf("audit: rotating %s: %w", f.filename, err)
+	}
+
+	return f.open()
+}
+
+// backup returns the name of the nth most recent backup.
+func (f *RotatingFile) backup(n int) string {
+	return fmt.Sprintf("%s.%d", f.filename, n)
+}
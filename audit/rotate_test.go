@@ -0,0 +1,160 @@
+package audit_test
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/crystalix007/log-viewer/audit"
+)
+
+// readFiles returns the contents of the audit log and its backups, by name
+// relative to the directory.
+func readFiles(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string]string, len(entries))
+
+	for _, entry := range entries {
+		contents, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		files[entry.Name()] = string(contents)
+	}
+
+	return files
+}
+
+// writeLines writes each line to the file, failing the test on error.
+func writeLines(t *testing.T, f *audit.RotatingFile, lines ...string) {
+	t.Helper()
+
+	for _, line := range lines {
+		if _, err := f.Write([]byte(line + "\n")); err != nil {
+			t.Fatalf("writing %q: %v", line, err)
+		}
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxSize    int64
+		maxBackups int
+		lines      []string
+		want       map[string]string
+	}{
+		{
+			name:       "within the maximum size",
+			maxSize:    12,
+			maxBackups: 2,
+			lines:      []string{"aaaaa", "bbbbb"},
+			want:       map[string]string{"audit.log": "aaaaa\nbbbbb\n"},
+		},
+		{
+			name:       "rotated",
+			maxSize:    10,
+			maxBackups: 2,
+			lines:      []string{"aaaaa", "bbbbb"},
+			want: map[string]string{
+				"audit.log":   "bbbbb\n",
+				"audit.log.1": "aaaaa\n",
+			},
+		},
+		{
+			name:       "oldest backups pruned",
+			maxSize:    10,
+			maxBackups: 2,
+			lines:      []string{"aaaaa", "bbbbb", "ccccc", "ddddd"},
+			want: map[string]string{
+				"audit.log":   "ddddd\n",
+				"audit.log.1": "ccccc\n",
+				"audit.log.2": "bbbbb\n",
+			},
+		},
+		{
+			name:    "without backups",
+			maxSize: 10,
+			lines:   []string{"aaaaa", "bbbbb", "ccccc"},
+			want:    map[string]string{"audit.log": "ccccc\n"},
+		},
+		{
+			name:       "write larger than the maximum size",
+			maxSize:    4,
+			maxBackups: 1,
+			lines:      []string{"aaaaa", "bbbbb"},
+			want: map[string]string{
+				"audit.log":   "bbbbb\n",
+				"audit.log.1": "aaaaa\n",
+			},
+		},
+		{
+			name:       "rotation disabled",
+			maxBackups: 2,
+			lines:      []string{"aaaaa", "bbbbb", "ccccc"},
+			want:       map[string]string{"audit.log": "aaaaa\nbbbbb\nccccc\n"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			f, err := audit.OpenRotatingFile(filepath.Join(dir, "audit.log"), test.maxSize, test.maxBackups)
+			if err != nil {
+				t.Fatalf("OpenRotatingFile: %v", err)
+			}
+
+			writeLines(t, f, test.lines...)
+
+			if err := f.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			if files := readFiles(t, dir); !maps.Equal(files, test.want) {
+				t.Errorf("wrote %q, want %q", files, test.want)
+			}
+		})
+	}
+}
+
+func TestRotatingFileReopened(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "audit.log")
+
+	f, err := audit.OpenRotatingFile(filename, 10, 1)
+	if err != nil {
+		t.Fatalf("OpenRotatingFile: %v", err)
+	}
+
+	writeLines(t, f, "aaaaa")
+	f.Close()
+
+	// The size of the existing file counts towards the maximum, so the next
+	// write rotates it.
+	f, err = audit.OpenRotatingFile(filename, 10, 1)
+	if err != nil {
+		t.Fatalf("reopening: %v", err)
+	}
+
+	defer f.Close()
+
+	writeLines(t, f, "bbbbb")
+
+	want := map[string]string{
+		"audit.log":   "bbbbb\n",
+		"audit.log.1": "aaaaa\n",
+	}
+
+	if files := readFiles(t, dir); !maps.Equal(files, want) {
+		t.Errorf("wrote %q, want %q", files, want)
+	}
+}
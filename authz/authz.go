@@ -0,0 +1,297 @@
+// Package authz provides path-based authorization of users viewing logs.
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/crystalix007/log-viewer/middleware"
+)
+
+var (
+	// ErrInvalidEffect is returned when a rule's effect is neither "allow"
+	// nor "deny".
+	ErrInvalidEffect = errors.New("authz: invalid effect")
+
+	// ErrInvalidPattern is returned when a rule's path glob is malformed.
+	ErrInvalidPattern = errors.New("authz: invalid path pattern")
+
+	// ErrInvalidPermission is returned when a grant names an unknown
+	// permission.
+	ErrInvalidPermission = errors.New("authz: invalid permission")
+)
+
+// Effect is the outcome of a rule matching a request.
+type Effect string
+
+const (
+	// Allow grants access to the matched paths.
+	Allow Effect = "allow"
+
+	// Deny refuses access to the matched paths, overriding any rules which
+	// allow it.
+	Deny Effect = "deny"
+)
+
+// Permission is a privilege granted to users beyond viewing logs.
+type Permission string
+
+const (
+	// ViewUnredacted permits viewing logs without secrets and personal data
+	// being redacted.
+	ViewUnredacted Permission = "view-unredacted"
+
+	// ViewAudit permits viewing the audit log of who viewed which logs.
+	ViewAudit Permission = "view-audit"
+)
+
+// permissions are the known permissions.
+var permissions = []Permission{ViewUnredacted, ViewAudit}
+
+// Wildcard matches any authenticated user or group in a rule.
+const Wildcard = "*"
+
+// Policy is a set of rules determining which logs each user may view.
+//
+// A path is denied if any rule applying to the user denies it, and otherwise
+// allowed if any rule applying to the user allows it. If no rule matches, the
+// default effect applies.
+type Policy struct {
+	Default Effect  `json:"default"`
+	Rules   []Rule  `json:"rules"`
+	Grants  []Grant `json:"grants,omitempty"`
+}
+
+// Rule allows or denies a set of users and groups access to a set of paths.
+type Rule struct {
+	Effect Effect   `json:"effect"`
+	Users  []string `json:"users,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+
+	// Paths are globs over log paths (including the root segment), where "*"
+	// matches within a single segment and "**" matches any number of
+	// segments, e.g. "prod/payments/**".
+	Paths []string `json:"paths,omitempty"`
+
+	// Namespaces match the second segment of log paths, i.e. the namespace
+	// in roots laid out as "<namespace>/<pod>/<container>".
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// Grant gives a set of users and groups additional permissions.
+type Grant struct {
+	Users       []string     `json:"users,omitempty"`
+	Groups      []string     `json:"groups,omitempty"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// Load reads a policy from a YAML or JSON file.
+func Load(filename string) (*Policy, error) {
+	bs, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("authz: reading policy: %w", err)
+	}
+
+	var policy Policy
+
+	if err := yaml.UnmarshalStrict(bs, &policy); err != nil {
+		return nil, fmt.Errorf("authz: decoding policy: %w", err)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// Validate checks that the policy's effects and patterns are well-formed,
+// defaulting to denying access.
+func (p *Policy) Validate() error {
+	if p.Default == "" {
+		p.Default = Deny
+	}
+
+	if p.Default != Allow && p.Default != Deny {
+		return fmt.Errorf("%w: default %q", ErrInvalidEffect, p.Default)
+	}
+
+	for i, rule := range p.Rules {
+		if rule.Effect != Allow && rule.Effect != Deny {
+			return fmt.Errorf("%w: rule %d has effect %q", ErrInvalidEffect, i, rule.Effect)
+		}
+
+		for _, pattern := range rule.patterns() {
+			for _, segment := range pattern {
+				if _, err := path.Match(segment, ""); err != nil {
+					return fmt.Errorf("%w: rule %d: %w", ErrInvalidPattern, i, err)
+				}
+			}
+		}
+	}
+
+	for i, grant := range p.Grants {
+		for _, permission := range grant.Permissions {
+			if !slices.Contains(permissions, permission) {
+				return fmt.Errorf("%w: grant %d has permission %q", ErrInvalidPermission, i, permission)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Permitted reports whether the user has been granted the permission.
+func (p *Policy) Permitted(user middleware.User, permission Permission) bool {
+	for _, grant := range p.Grants {
+		if matchesUser(grant.Users, grant.Groups, user) &&
+			slices.Contains(grant.Permissions, permission) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Allowed reports whether the user may view the log or directory at the
+// given path.
+func (p *Policy) Allowed(user middleware.User, logPath string) bool {
+	segments := splitPath(logPath)
+	allowed := p.Default == Allow
+
+	for _, rule := range p.Rules {
+		if !rule.appliesTo(user) || !rule.matches(segments) {
+			continue
+		}
+
+		if rule.Effect == Deny {
+			return false
+		}
+
+		allowed = true
+	}
+
+	return allowed
+}
+
+// Browsable reports whether the user may list the directory at the given
+// path, i.e. whether it, or anything beneath it, is allowed.
+func (p *Policy) Browsable(user middleware.User, directory string) bool {
+	if p.Allowed(user, directory) {
+		return true
+	}
+
+	segments := splitPath(directory)
+
+	for _, rule := range p.Rules {
+		if !rule.appliesTo(user) {
+			continue
+		}
+
+		// A denied subtree hides the directory, even if a rule allows
+		// something beneath it.
+		if rule.Effect == Deny && rule.matchesSubtree(segments) {
+			return false
+		}
+	}
+
+	for _, rule := range p.Rules {
+		if rule.Effect == Allow && rule.appliesTo(user) && rule.matchesBeneath(segments) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appliesTo reports whether the rule applies to the user, by name or group.
+func (r Rule) appliesTo(user middleware.User) bool {
+	return matchesUser(r.Users, r.Groups, user)
+}
+
+// matchesUser reports whether the user is one of the users, or a member of
+// one of the groups.
+func matchesUser(users []string, groups []string, user middleware.User) bool {
+	if user.Name != "" && (slices.Contains(users, Wildcard) || slices.Contains(users, user.Name)) {
+		return true
+	}
+
+	if user.Name != "" && slices.Contains(groups, Wildcard) {
+		return true
+	}
+
+	for _, group := range user.Groups {
+		if slices.Contains(groups, group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches reports whether the rule matches the path.
+func (r Rule) matches(segments []string) bool {
+	for _, pattern := range r.patterns() {
+		if matchGlob(pattern, segments) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesBeneath reports whether the rule could match a path beneath the
+// directory.
+func (r Rule) matchesBeneath(directory []string) bool {
+	for _, pattern := range r.patterns() {
+		if matchGlobPrefix(pattern, directory) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesSubtree reports whether the rule matches every path beneath the
+// directory.
+func (r Rule) matchesSubtree(directory []string) bool {
+	for _, pattern := range r.patterns() {
+		if matchGlobSubtree(pattern, directory) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// patterns returns the rule's path globs, split into segments, including
+// those implied by its namespaces.
+func (r Rule) patterns() [][]string {
+	var patterns [][]string
+
+	for _, pattern := range r.Paths {
+		patterns = append(patterns, splitPath(pattern))
+	}
+
+	for _, namespace := range r.Namespaces {
+		patterns = append(patterns, []string{"*", namespace, "**"})
+	}
+
+	return patterns
+}
+
+// splitPath splits a path into its non-empty segments.
+func splitPath(p string) []string {
+	cleanPath := strings.Trim(path.Clean("/"+p), "/")
+	if cleanPath == "" {
+		return nil
+	}
+
+	return strings.Split(cleanPath, "/")
+}
@@ -0,0 +1,48 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/crystalix007/log-viewer/authz"
+	"github.com/crystalix007/log-viewer/middleware"
+)
+
+func TestBrowsable(t *testing.T) {
+	policy := &authz.Policy{
+		Default: authz.Deny,
+		Rules: []authz.Rule{
+			{Effect: authz.Allow, Users: []string{"*"}, Paths: []string{"prod/**"}},
+			{Effect: authz.Deny, Users: []string{"*"}, Paths: []string{"prod/secret/**"}},
+			{Effect: authz.Allow, Users: []string{"*"}, Paths: []string{"ops/apps/web/**"}},
+			{Effect: authz.Deny, Users: []string{"*"}, Paths: []string{"ops/apps/*"}},
+			{Effect: authz.Allow, Users: []string{"*"}, Paths: []string{"staging/team/**"}},
+			{Effect: authz.Deny, Users: []string{"*"}, Paths: []string{"staging/*/private/**"}},
+		},
+	}
+
+	user := middleware.User{Name: "alice"}
+
+	tests := []struct {
+		directory string
+		want      bool
+	}{
+		{"prod", true},
+		{"prod/secret", false},
+		{"prod/secret/nested", false},
+		// A rule denying only the entries of a directory does not deny the
+		// paths beneath them.
+		{"ops", true},
+		{"ops/apps", true},
+		{"staging", true},
+		{"staging/team", true},
+		{"staging/team/private", false},
+		{"staging/team/private/logs", false},
+		{"staging/other", false},
+	}
+
+	for _, test := range tests {
+		if got := policy.Browsable(user, test.directory); got != test.want {
+			t.Errorf("Browsable(%q) = %v, want %v", test.directory, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package authz
+
+import "path"
+
+// matchGlob reports whether the path segments match the pattern segments,
+// where "**" matches any number of segments (including none).
+func matchGlob(pattern []string, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for skip := 0; skip <= len(segments); skip++ {
+				if matchGlob(pattern[1:], segments[skip:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(segments) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+			return false
+		}
+
+		pattern = pattern[1:]
+		segments = segments[1:]
+	}
+
+	return len(segments) == 0
+}
+
+// matchGlobPrefix reports whether the pattern could match a path beneath the
+// directory's segments.
+func matchGlobPrefix(pattern []string, directory []string) bool {
+	for len(directory) > 0 {
+		if len(pattern) == 0 {
+			return false
+		}
+
+		if pattern[0] == "**" {
+			return true
+		}
+
+		if ok, _ := path.Match(pattern[0], directory[0]); !ok {
+			return false
+		}
+
+		pattern = pattern[1:]
+		directory = directory[1:]
+	}
+
+	return len(pattern) > 0
+}
+
+// matchGlobSubtree reports whether the pattern matches every path beneath the
+// directory's segments, i.e. it ends in "**" and the rest of the pattern
+// matches the directory or one of its ancestors.
+func matchGlobSubtree(pattern []string, directory []string) bool {
+	if len(pattern) == 0 || pattern[len(pattern)-1] != "**" {
+		return false
+	}
+
+	for ancestor := 0; ancestor <= len(directory); ancestor++ {
+		if matchGlob(pattern[:len(pattern)-1], directory[:ancestor]) {
+			return true
+		}
+	}
+
+	return false
+}
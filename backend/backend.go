@@ -0,0 +1,93 @@
+// Package backend defines the sources that logs can be served from.
+package backend
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	// ErrNotExist is returned when the requested path does not exist within
+	// the backend.
+	ErrNotExist = errors.New("backend: path does not exist")
+
+	// ErrUnsafePath is returned when a path is unsafe, i.e. it escapes the
+	// root of the backend.
+	ErrUnsafePath = errors.New("backend: path is unsafe")
+)
+
+// Entry represents a single entry within a log directory.
+type Entry struct {
+	Name string
+	Dir  bool
+
+	// SymlinkTarget is the target of the entry, if it is a symbolic link.
+	SymlinkTarget string
+}
+
+// Info describes a single log file.
+type Info struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+
+	// Writing reports whether lines may still be appended to the log, as
+	// judged by its backend, e.g. because the container writing it is
+	// running. Backends which cannot know guess, or leave it false.
+	Writing bool
+}
+
+// Backend is a source of log files, organised as a directory hierarchy.
+//
+// Paths are slash-separated, and are relative to the root of the backend.
+type Backend interface {
+	// ReadDir lists the entries of the directory at the given path.
+	ReadDir(ctx context.Context, path string) ([]Entry, error)
+
+	// Stat returns the details of the log file at the given path.
+	Stat(ctx context.Context, path string) (Info, error)
+
+	// Open opens the log file at the given path for reading.
+	//
+	// The returned reader may additionally implement [io.Seeker] and
+	// [io.ReaderAt], if the backend supports random access.
+	Open(ctx context.Context, path string) (io.ReadCloser, error)
+}
+
+// StreamOptions configures how a live log is streamed.
+type StreamOptions struct {
+	// Follow keeps the stream open, returning new lines as they are written.
+	Follow bool
+
+	// Previous returns the logs of the previous instance of the log's source,
+	// e.g. a container that has since restarted.
+	Previous bool
+
+	// SinceTime only returns lines written at or after the given time.
+	SinceTime *time.Time
+
+	// TailLines only returns the given number of lines from the end of the
+	// log.
+	TailLines *int64
+
+	// Timestamps prefixes each line with the RFC 3339 time it was written.
+	Timestamps bool
+}
+
+// Streamer is implemented by backends serving live logs, which can be opened
+// with additional [StreamOptions].
+type Streamer interface {
+	// Stream opens the log at the given path with the given options.
+	Stream(ctx context.Context, path string, opts StreamOptions) (io.ReadCloser, error)
+}
+
+// Resolver is implemented by backends in which several paths may refer to the
+// same log, such as through symbolic links.
+type Resolver interface {
+	// Resolve returns the path that the given path refers to, once any
+	// aliases are followed. Paths referring outside of the backend's own
+	// hierarchy, such as to an allowed symlink target, are returned as given.
+	Resolve(ctx context.Context, path string) (string, error)
+}
@@ -0,0 +1,103 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PodEventsFile is the name of the file containing an archived pod's events,
+// as output by `kubectl get events -o json`.
+const PodEventsFile = "events.json"
+
+// Event is a Kubernetes event concerning a pod.
+type Event struct {
+	Time    time.Time
+	Type    string
+	Reason  string
+	Message string
+	Object  string
+	Count   int
+}
+
+// EventSource is implemented by backends which can retrieve the events of a
+// pod directly.
+type EventSource interface {
+	// PodEvents returns the events of the pod at the given path, i.e.
+	// "<namespace>/<pod>", ordered by time.
+	PodEvents(ctx context.Context, podPath string) ([]Event, error)
+}
+
+// PodEvents returns the events of the pod at the given path, ordered by time.
+//
+// If the backend does not implement [EventSource], the events are read from
+// the dump stored alongside archived logs. Pods without any events recorded
+// return no events, rather than an error.
+func PodEvents(ctx context.Context, b Backend, podPath string) ([]Event, error) {
+	if eventSource, ok := b.(EventSource); ok {
+		return eventSource.PodEvents(ctx, podPath)
+	}
+
+	file, err := b.Open(ctx, path.Join(podPath, PodEventsFile))
+	if errors.Is(err, ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	bs, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("backend: reading pod events: %w", err)
+	}
+
+	var eventList corev1.EventList
+
+	if err := json.Unmarshal(bs, &eventList); err != nil {
+		return nil, fmt.Errorf("backend: decoding pod events: %w", err)
+	}
+
+	return NewEvents(eventList.Items), nil
+}
+
+// NewEvents converts Kubernetes events, ordering them by time.
+func NewEvents(kubernetesEvents []corev1.Event) []Event {
+	events := make([]Event, len(kubernetesEvents))
+
+	for i, event := range kubernetesEvents {
+		events[i] = Event{
+			Time:    eventTime(event),
+			Type:    event.Type,
+			Reason:  event.Reason,
+			Message: event.Message,
+			Object:  path.Join(event.InvolvedObject.Kind, event.InvolvedObject.Name),
+			Count:   int(event.Count),
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b Event) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	return events
+}
+
+// eventTime returns the time that the event most recently occurred.
+func eventTime(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.FirstTimestamp.Time
+	}
+}
@@ -0,0 +1,369 @@
+// Package filesystem provides a backend serving logs from a local directory.
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crystalix007/log-viewer/backend"
+)
+
+// maxSymlinks is the number of symbolic links that will be followed when
+// resolving a single path, matching the limit of Linux.
+const maxSymlinks = 40
+
+// writingWindow is how recently a file must have been modified to be
+// considered still being written. Nothing records whether a file is still open
+// for writing, so this is only a heuristic.
+const writingWindow = time.Minute
+
+// Backend serves logs from a directory on the local filesystem.
+//
+// Paths are confined to the root directory. Symbolic links are resolved
+// according to the backend's [SymlinkPolicy], and files are then opened
+// relative to the directory containing them (as with openat), so that the
+// filesystem changing between the check and the open cannot escape it.
+type Backend struct {
+	root           string
+	symlinkPolicy  SymlinkPolicy
+	symlinkTargets []string
+}
+
+// Ensure that Backend implements the backend.Backend and backend.Resolver
+// interfaces.
+var (
+	_ backend.Backend  = &Backend{}
+	_ backend.Resolver = &Backend{}
+)
+
+// Option represents a value that can be configured on a Backend.
+type Option func(b *Backend)
+
+// WithSymlinkPolicy sets the policy for following symbolic links.
+func WithSymlinkPolicy(policy SymlinkPolicy) Option {
+	return func(b *Backend) {
+		b.symlinkPolicy = policy
+	}
+}
+
+// WithSymlinkTargets sets the directories outside of the root that symbolic
+// links may point into, when using [SymlinkAllowList].
+func WithSymlinkTargets(directories ...string) Option {
+	return func(b *Backend) {
+		for _, directory := range directories {
+			b.symlinkTargets = append(b.symlinkTargets, resolveDirectory(directory))
+		}
+	}
+}
+
+// New creates a new filesystem backend, rooted at the given directory.
+func New(root string, opts ...Option) *Backend {
+	b := &Backend{
+		root: resolveDirectory(root),
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// Root returns the directory that the backend serves, with any symbolic links
+// in its path resolved.
+func (b *Backend) Root() string {
+	return b.root
+}
+
+// resolveDirectory resolves any symbolic links in the path of a trusted
+// directory, so that symlink targets can be compared against it.
+func resolveDirectory(directory string) string {
+	resolved, err := filepath.EvalSymlinks(directory)
+	if err != nil {
+		return filepath.Clean(directory)
+	}
+
+	return resolved
+}
+
+// ReadDir lists the entries of the directory at the given path.
+//
+// Symbolic links are listed with their targets, and are only reported as
+// directories if the policy allows following them.
+func (b *Backend) ReadDir(
+	ctx context.Context,
+	requestPath string,
+) ([]backend.Entry, error) {
+	location, err := b.getSafePath(requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	directory, err := location.open()
+	if err != nil {
+		return nil, err
+	}
+
+	defer directory.Close()
+
+	direntries, err := directory.ReadDir(-1)
+	if err != nil {
+		return nil, fmt.Errorf("filesystem: reading directory: %w", err)
+	}
+
+	// Entries are read in directory order, which is unspecified, so are sorted
+	// to be listed consistently.
+	slices.SortFunc(direntries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	entries := make([]backend.Entry, len(direntries))
+
+	for i, direntry := range direntries {
+		entries[i] = backend.Entry{
+			Name: direntry.Name(),
+			Dir:  direntry.IsDir(),
+		}
+
+		if direntry.Type()&fs.ModeSymlink == 0 {
+			continue
+		}
+
+		entries[i].SymlinkTarget, err = os.Readlink(
+			filepath.Join(location.base, location.rel, direntry.Name()),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("filesystem: reading symlink: %w", err)
+		}
+
+		if info, err := b.stat(path.Join(requestPath, direntry.Name())); err == nil {
+			entries[i].Dir = info.IsDir()
+		}
+	}
+
+	return entries, nil
+}
+
+// Stat returns the details of the log file at the given path.
+func (b *Backend) Stat(
+	ctx context.Context,
+	requestPath string,
+) (backend.Info, error) {
+	fileInfo, err := b.stat(requestPath)
+	if err != nil {
+		return backend.Info{}, err
+	}
+
+	return backend.Info{
+		Name:    fileInfo.Name(),
+		Size:    fileInfo.Size(),
+		ModTime: fileInfo.ModTime(),
+		Writing: time.Since(fileInfo.ModTime()) < writingWindow,
+	}, nil
+}
+
+// Open opens the log file at the given path for reading.
+func (b *Backend) Open(
+	ctx context.Context,
+	requestPath string,
+) (io.ReadCloser, error) {
+	location, err := b.getSafePath(requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return location.open()
+}
+
+// Resolve returns the path within the root that the given path refers to, once
+// symbolic links are followed.
+func (b *Backend) Resolve(
+	ctx context.Context,
+	requestPath string,
+) (string, error) {
+	location, err := b.getSafePath(requestPath)
+	if err != nil {
+		return "", err
+	}
+
+	// Allowed symlink targets outside of the root have no path within it.
+	if location.base != b.root {
+		return requestPath, nil
+	}
+
+	return path.Clean("/" + filepath.ToSlash(location.rel)), nil
+}
+
+// stat resolves the given path and returns its file information.
+func (b *Backend) stat(requestPath string) (fs.FileInfo, error) {
+	location, err := b.getSafePath(requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := os.OpenRoot(location.base)
+	if err != nil {
+		return nil, convertError(err, "opening root")
+	}
+
+	defer root.Close()
+
+	fileInfo, err := root.Stat(location.rel)
+	if err != nil {
+		return nil, convertError(err, "stat")
+	}
+
+	return fileInfo, nil
+}
+
+// location is a path relative to a base directory, which is either the root
+// or one of the allowed symlink targets.
+type location struct {
+	base string
+	rel  string
+}
+
+// open opens the file at the location, confined to its base directory.
+func (l location) open() (*os.File, error) {
+	root, err := os.OpenRoot(l.base)
+	if err != nil {
+		return nil, convertError(err, "opening root")
+	}
+
+	defer root.Close()
+
+	file, err := root.Open(l.rel)
+	if err != nil {
+		return nil, convertError(err, "opening file")
+	}
+
+	return file, nil
+}
+
+// getSafePath resolves the request path within the root, following symbolic
+// links as allowed by the symlink policy, and ensuring that the result does
+// not escape the root or the allowed symlink targets.
+func (b *Backend) getSafePath(
+	requestPath string,
+) (location, error) {
+	// Cleaning a rooted path removes any ".." components which would
+	// otherwise escape the root.
+	cleanPath := strings.Trim(path.Clean("/"+requestPath), "/")
+
+	var remaining []string
+
+	if cleanPath != "" {
+		remaining = strings.Split(cleanPath, "/")
+	}
+
+	var (
+		base     = b.root
+		resolved []string
+		symlinks int
+	)
+
+	for len(remaining) > 0 {
+		component := remaining[0]
+		remaining = remaining[1:]
+
+		current := filepath.Join(base, filepath.Join(resolved...), component)
+
+		fileInfo, err := os.Lstat(current)
+		if err != nil {
+			// Missing paths are reported when the location is opened.
+			resolved = append(resolved, component)
+			resolved = append(resolved, remaining...)
+
+			break
+		}
+
+		if fileInfo.Mode()&fs.ModeSymlink == 0 {
+			resolved = append(resolved, component)
+
+			continue
+		}
+
+		if b.symlinkPolicy == SymlinkDeny {
+			return location{}, backend.ErrUnsafePath
+		}
+
+		symlinks++
+		if symlinks > maxSymlinks {
+			return location{}, backend.ErrUnsafePath
+		}
+
+		target, err := os.Readlink(current)
+		if err != nil {
+			return location{}, fmt.Errorf("filesystem: reading symlink: %w", err)
+		}
+
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(base, filepath.Join(resolved...), target)
+		}
+
+		targetBase, targetComponents, ok := b.contain(filepath.Clean(target))
+		if !ok {
+			return location{}, backend.ErrUnsafePath
+		}
+
+		// The target may itself contain symbolic links, so is resolved again
+		// from its base.
+		base = targetBase
+		resolved = nil
+		remaining = append(targetComponents, remaining...)
+	}
+
+	rel := filepath.Join(resolved...)
+	if rel == "" {
+		rel = "."
+	}
+
+	return location{
+		base: base,
+		rel:  rel,
+	}, nil
+}
+
+// contain finds the directory that the given absolute path is within, out of
+// the root and (if allowed by the policy) the allowed symlink targets,
+// returning the components of the path relative to it.
+func (b *Backend) contain(target string) (string, []string, bool) {
+	bases := []string{b.root}
+
+	if b.symlinkPolicy == SymlinkAllowList {
+		bases = append(bases, b.symlinkTargets...)
+	}
+
+	for _, base := range bases {
+		rel, err := filepath.Rel(base, target)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+			continue
+		}
+
+		if rel == "." {
+			return base, nil, true
+		}
+
+		return base, strings.Split(rel, string(filepath.Separator)), true
+	}
+
+	return "", nil, false
+}
+
+// convertError converts filesystem errors into backend errors.
+func convertError(err error, action string) error {
+	if errors.Is(err, os.ErrNotExist) {
+		return backend.ErrNotExist
+	}
+
+	return fmt.Errorf("filesystem: %s: %w", action, err)
+}
@@ -0,0 +1,47 @@
+package filesystem_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/crystalix007/log-viewer/backend"
+	"github.com/crystalix007/log-viewer/backend/filesystem"
+)
+
+func TestReadDirSorted(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, name := range []string{"c.log", "a.log", "b", "B.log", "d.log"} {
+		if name == "b" {
+			if err := os.Mkdir(filepath.Join(dir, name), 0o755); err != nil {
+				t.Fatal(err)
+			}
+
+			continue
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := filesystem.New(dir).ReadDir(context.Background(), "/")
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+
+	want := []backend.Entry{
+		{Name: "B.log"},
+		{Name: "a.log"},
+		{Name: "b", Dir: true},
+		{Name: "c.log"},
+		{Name: "d.log"},
+	}
+
+	if !slices.Equal(entries, want) {
+		t.Errorf("ReadDir = %v, want %v", entries, want)
+	}
+}
@@ -0,0 +1,52 @@
+package filesystem
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSymlinkPolicy is returned when parsing an unknown symlink policy.
+var ErrInvalidSymlinkPolicy = errors.New("filesystem: invalid symlink policy")
+
+// SymlinkPolicy determines which symbolic links a backend will follow.
+type SymlinkPolicy int
+
+const (
+	// SymlinkAllowWithinRoot follows symbolic links whose targets are within
+	// the root directory.
+	SymlinkAllowWithinRoot SymlinkPolicy = iota
+
+	// SymlinkDeny refuses to follow any symbolic links.
+	SymlinkDeny
+
+	// SymlinkAllowList follows symbolic links whose targets are within the
+	// root directory, or within any of the allowed symlink targets.
+	SymlinkAllowList
+)
+
+// symlinkPolicyNames maps symlink policies to their names.
+var symlinkPolicyNames = map[SymlinkPolicy]string{
+	SymlinkAllowWithinRoot: "allow-within-root",
+	SymlinkDeny:            "deny",
+	SymlinkAllowList:       "allow-list",
+}
+
+// ParseSymlinkPolicy parses a symlink policy from its name.
+func ParseSymlinkPolicy(name string) (SymlinkPolicy, error) {
+	for policy, policyName := range symlinkPolicyNames {
+		if policyName == name {
+			return policy, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrInvalidSymlinkPolicy, name)
+}
+
+// String returns the name of the symlink policy.
+func (p SymlinkPolicy) String() string {
+	if name, ok := symlinkPolicyNames[p]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("SymlinkPolicy(%d)", int(p))
+}
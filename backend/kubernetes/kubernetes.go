@@ -0,0 +1,354 @@
+// Package kubernetes provides a backend serving live logs from the Kubernetes
+// API server.
+//
+// Logs are presented as a directory hierarchy of namespaces, pods and
+// containers, i.e. "<namespace>/<pod>/<container>".
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/crystalix007/log-viewer/backend"
+)
+
+// Backend serves live container logs from the Kubernetes API server.
+type Backend struct {
+	client k8s.Interface
+}
+
+// Ensure that Backend implements the backend.Backend, backend.Streamer,
+// backend.PodSelector and backend.EventSource interfaces.
+var (
+	_ backend.Backend     = &Backend{}
+	_ backend.Streamer    = &Backend{}
+	_ backend.PodSelector = &Backend{}
+	_ backend.EventSource = &Backend{}
+)
+
+// LoadConfig loads the REST config for the API server.
+//
+// If kubeconfig is empty, the default loading rules are used, falling back to
+// the in-cluster config when running inside a pod. If kubeContext is empty,
+// the current context of the kubeconfig is used.
+func LoadConfig(kubeconfig string, kubeContext string) (*rest.Config, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		loadingRules,
+		&clientcmd.ConfigOverrides{
+			CurrentContext: kubeContext,
+		},
+	).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("kubernetes: loading config: %w", err)
+	}
+
+	return config, nil
+}
+
+// New creates a new Kubernetes backend, connecting to the API server described
+// by the given config.
+func New(config *rest.Config) (*Backend, error) {
+	client, err := k8s.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("kubernetes: creating client: %w", err)
+	}
+
+	return NewForClient(client), nil
+}
+
+// NewForClient creates a new Kubernetes backend using an existing client.
+func NewForClient(client k8s.Interface) *Backend {
+	return &Backend{
+		client: client,
+	}
+}
+
+// ReadDir lists the namespaces, pods in a namespace, or containers in a pod,
+// depending on the depth of the given path.
+func (b *Backend) ReadDir(
+	ctx context.Context,
+	requestPath string,
+) ([]backend.Entry, error) {
+	segments := splitPath(requestPath)
+
+	switch len(segments) {
+	case 0:
+		namespaces, err := b.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("kubernetes: listing namespaces: %w", err)
+		}
+
+		entries := make([]backend.Entry, len(namespaces.Items))
+
+		for i, namespace := range namespaces.Items {
+			entries[i] = backend.Entry{
+				Name: namespace.Name,
+				Dir:  true,
+			}
+		}
+
+		return entries, nil
+	case 1:
+		if _, err := b.client.CoreV1().Namespaces().Get(ctx, segments[0], metav1.GetOptions{}); err != nil {
+			return nil, convertError(err, "getting namespace")
+		}
+
+		pods, err := b.client.CoreV1().Pods(segments[0]).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("kubernetes: listing pods: %w", err)
+		}
+
+		entries := make([]backend.Entry, len(pods.Items))
+
+		for i, pod := range pods.Items {
+			entries[i] = backend.Entry{
+				Name: pod.Name,
+				Dir:  true,
+			}
+		}
+
+		return entries, nil
+	case 2:
+		pod, err := b.client.CoreV1().Pods(segments[0]).Get(ctx, segments[1], metav1.GetOptions{})
+		if err != nil {
+			return nil, convertError(err, "getting pod")
+		}
+
+		var entries []backend.Entry
+
+		for _, name := range containerNames(pod) {
+			entries = append(entries, backend.Entry{
+				Name: name,
+			})
+		}
+
+		return entries, nil
+	default:
+		return nil, backend.ErrNotExist
+	}
+}
+
+// Stat returns the details of the container log at the given path.
+//
+// The size of a live log is not known without reading it, so is reported as
+// zero. The modification time is the time the container last changed state.
+func (b *Backend) Stat(
+	ctx context.Context,
+	requestPath string,
+) (backend.Info, error) {
+	segments := splitPath(requestPath)
+	if len(segments) != 3 {
+		return backend.Info{}, backend.ErrNotExist
+	}
+
+	pod, err := b.client.CoreV1().Pods(segments[0]).Get(ctx, segments[1], metav1.GetOptions{})
+	if err != nil {
+		return backend.Info{}, convertError(err, "getting pod")
+	}
+
+	for _, name := range containerNames(pod) {
+		if name == segments[2] {
+			return backend.Info{
+				Name:    name,
+				ModTime: containerModTime(pod, name),
+				Writing: containerRunning(pod, name),
+			}, nil
+		}
+	}
+
+	return backend.Info{}, backend.ErrNotExist
+}
+
+// Open opens the current log of the container at the given path.
+func (b *Backend) Open(
+	ctx context.Context,
+	requestPath string,
+) (io.ReadCloser, error) {
+	return b.Stream(ctx, requestPath, backend.StreamOptions{})
+}
+
+// Stream opens the log of the container at the given path, as with
+// `kubectl logs`.
+func (b *Backend) Stream(
+	ctx context.Context,
+	requestPath string,
+	opts backend.StreamOptions,
+) (io.ReadCloser, error) {
+	if _, err := b.Stat(ctx, requestPath); err != nil {
+		return nil, err
+	}
+
+	segments := splitPath(requestPath)
+
+	logOptions := corev1.PodLogOptions{
+		Container:  segments[2],
+		Follow:     opts.Follow,
+		Previous:   opts.Previous,
+		TailLines:  opts.TailLines,
+		Timestamps: opts.Timestamps,
+	}
+
+	if opts.SinceTime != nil {
+		sinceTime := metav1.NewTime(*opts.SinceTime)
+		logOptions.SinceTime = &sinceTime
+	}
+
+	stream, err := b.client.CoreV1().
+		Pods(segments[0]).
+		GetLogs(segments[1], &logOptions).
+		Stream(ctx)
+	if err != nil {
+		return nil, convertError(err, "streaming logs")
+	}
+
+	return stream, nil
+}
+
+// SelectPods returns the pods in the namespace matching the label selector.
+func (b *Backend) SelectPods(
+	ctx context.Context,
+	namespace string,
+	selector string,
+) ([]backend.Pod, error) {
+	if _, err := labels.Parse(selector); err != nil {
+		return nil, fmt.Errorf("%w: %w", backend.ErrInvalidSelector, err)
+	}
+
+	pods, err := b.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("kubernetes: listing pods: %w", err)
+	}
+
+	selected := make([]backend.Pod, len(pods.Items))
+
+	for i, pod := range pods.Items {
+		selected[i] = backend.Pod{
+			Namespace:  pod.Namespace,
+			Name:       pod.Name,
+			Containers: containerNames(&pod),
+		}
+	}
+
+	return selected, nil
+}
+
+// PodEvents returns the events of the pod at the given path, ordered by time.
+func (b *Backend) PodEvents(
+	ctx context.Context,
+	podPath string,
+) ([]backend.Event, error) {
+	segments := splitPath(podPath)
+	if len(segments) != 2 {
+		return nil, backend.ErrNotExist
+	}
+
+	events, err := b.client.CoreV1().Events(segments[0]).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector("involvedObject.kind", "Pod"),
+			fields.OneTermEqualSelector("involvedObject.name", segments[1]),
+		).String(),
+	})
+	if err != nil {
+		return nil, convertError(err, "listing events")
+	}
+
+	return backend.NewEvents(events.Items), nil
+}
+
+// splitPath splits a request path into its non-empty segments.
+func splitPath(requestPath string) []string {
+	cleanPath := strings.Trim(path.Clean("/"+requestPath), "/")
+	if cleanPath == "" {
+		return nil
+	}
+
+	return strings.Split(cleanPath, "/")
+}
+
+// containerNames returns the names of all containers in the pod, including
+// init and ephemeral containers.
+func containerNames(pod *corev1.Pod) []string {
+	var names []string
+
+	for _, container := range pod.Spec.InitContainers {
+		names = append(names, container.Name)
+	}
+
+	for _, container := range pod.Spec.Containers {
+		names = append(names, container.Name)
+	}
+
+	for _, container := range pod.Spec.EphemeralContainers {
+		names = append(names, container.Name)
+	}
+
+	return names
+}
+
+// containerModTime returns the time that the named container last changed
+// state, or the pod's creation time if the container has not yet started.
+func containerModTime(pod *corev1.Pod, name string) time.Time {
+	status, ok := containerStatus(pod, name)
+
+	switch {
+	case ok && status.State.Terminated != nil:
+		return status.State.Terminated.FinishedAt.Time
+	case ok && status.State.Running != nil:
+		return status.State.Running.StartedAt.Time
+	}
+
+	return pod.CreationTimestamp.Time
+}
+
+// containerRunning reports whether the named container is running, and so may
+// still be writing its log.
+func containerRunning(pod *corev1.Pod, name string) bool {
+	status, ok := containerStatus(pod, name)
+
+	return ok && status.State.Running != nil
+}
+
+// containerStatus returns the status of the named container of the pod.
+func containerStatus(pod *corev1.Pod, name string) (corev1.ContainerStatus, bool) {
+	statuses := slices.Concat(
+		pod.Status.InitContainerStatuses,
+		pod.Status.ContainerStatuses,
+		pod.Status.EphemeralContainerStatuses,
+	)
+
+	for _, status := range statuses {
+		if status.Name == name {
+			return status, true
+		}
+	}
+
+	return corev1.ContainerStatus{}, false
+}
+
+// convertError converts API server errors into backend errors.
+func convertError(err error, action string) error {
+	if apierrors.IsNotFound(err) {
+		return backend.ErrNotExist
+	}
+
+	return fmt.Errorf("kubernetes: %s: %w", action, err)
+}
@@ -0,0 +1,255 @@
+package kubernetes_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/crystalix007/log-viewer/backend"
+	"github.com/crystalix007/log-viewer/backend/kubernetes"
+)
+
+const (
+	testNamespace = "default"
+	testPod       = "app-1"
+	testContainer = "main"
+	testLogPath   = testNamespace + "/" + testPod + "/" + testContainer
+)
+
+// fakeAPIServer is an in-process stand-in for the Kubernetes API server,
+// serving a single pod and the log of its container. Following logs write the
+// log and then block until the request is cancelled, as a live container
+// which has stopped logging would.
+type fakeAPIServer struct {
+	log string
+
+	mu      sync.Mutex
+	queries []url.Values
+}
+
+func (f *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	podPath := "/api/v1/namespaces/" + testNamespace + "/pods/" + testPod
+
+	switch r.URL.Path {
+	case podPath:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(corev1.Pod{
+			TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testPod,
+				Namespace: testNamespace,
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: testContainer}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name: testContainer,
+					State: corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{
+							StartedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+						},
+					},
+				}},
+			},
+		})
+	case podPath + "/log":
+		f.mu.Lock()
+		f.queries = append(f.queries, r.URL.Query())
+		f.mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/plain")
+		io.WriteString(w, f.log)
+
+		if r.URL.Query().Get("follow") == "true" {
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		}
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(metav1.Status{
+			TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+			Status:   metav1.StatusFailure,
+			Reason:   metav1.StatusReasonNotFound,
+			Code:     http.StatusNotFound,
+		})
+	}
+}
+
+// lastQuery returns the query of the most recent log request.
+func (f *fakeAPIServer) lastQuery() url.Values {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.queries) == 0 {
+		return nil
+	}
+
+	return f.queries[len(f.queries)-1]
+}
+
+func newTestBackend(t *testing.T, log string) (*kubernetes.Backend, *fakeAPIServer) {
+	t.Helper()
+
+	fake := &fakeAPIServer{log: log}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	b, err := kubernetes.New(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatalf("creating backend: %v", err)
+	}
+
+	return b, fake
+}
+
+func TestStreamOptions(t *testing.T) {
+	sinceTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tailLines := int64(5)
+
+	tests := []struct {
+		name  string
+		opts  backend.StreamOptions
+		param string
+		want  string
+	}{
+		{
+			name:  "follow",
+			opts:  backend.StreamOptions{Follow: true},
+			param: "follow",
+			want:  "true",
+		},
+		{
+			name:  "previous",
+			opts:  backend.StreamOptions{Previous: true},
+			param: "previous",
+			want:  "true",
+		},
+		{
+			name:  "sinceTime",
+			opts:  backend.StreamOptions{SinceTime: &sinceTime},
+			param: "sinceTime",
+			want:  "2024-01-02T03:04:05Z",
+		},
+		{
+			name:  "tailLines",
+			opts:  backend.StreamOptions{TailLines: &tailLines},
+			param: "tailLines",
+			want:  "5",
+		},
+		{
+			name:  "timestamps",
+			opts:  backend.StreamOptions{Timestamps: true},
+			param: "timestamps",
+			want:  "true",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, fake := newTestBackend(t, "line 1\nline 2\n")
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			stream, err := b.Stream(ctx, testLogPath, test.opts)
+			if err != nil {
+				t.Fatalf("Stream: %v", err)
+			}
+
+			io.Copy(io.Discard, stream)
+			stream.Close()
+
+			query := fake.lastQuery()
+
+			if got := query.Get(test.param); got != test.want {
+				t.Errorf("%s = %q, want %q (query %v)", test.param, got, test.want, query)
+			}
+
+			if got := query.Get("container"); got != testContainer {
+				t.Errorf("container = %q, want %q", got, testContainer)
+			}
+
+			// Only the requested option is set.
+			for _, param := range []string{"follow", "previous", "sinceTime", "tailLines", "timestamps"} {
+				if param != test.param && query.Has(param) {
+					t.Errorf("unexpected %s=%q", param, query.Get(param))
+				}
+			}
+		})
+	}
+}
+
+func TestStreamFollowTimeout(t *testing.T) {
+	b, _ := newTestBackend(t, "line 1\nline 2\n")
+
+	// As with the follow timeout of the API, the context bounds how long a
+	// followed log is waited on.
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	stream, err := b.Stream(ctx, testLogPath, backend.StreamOptions{Follow: true})
+	if err != nil {
+		t.Fatalf("Stream: %v", err)
+	}
+
+	defer stream.Close()
+
+	start := time.Now()
+	contents, err := io.ReadAll(stream)
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("the stream ended %v after the timeout", elapsed)
+	}
+
+	if err == nil || ctx.Err() == nil {
+		t.Errorf("reading ended with %v before the timeout", err)
+	}
+
+	if string(contents) != "line 1\nline 2\n" {
+		t.Errorf("read %q before the timeout, want both lines", contents)
+	}
+}
+
+func TestNotExist(t *testing.T) {
+	b, _ := newTestBackend(t, "")
+
+	ctx := context.Background()
+
+	for _, logPath := range []string{
+		testNamespace + "/missing/" + testContainer,
+		testNamespace + "/" + testPod + "/missing",
+		testNamespace,
+	} {
+		if _, err := b.Stream(ctx, logPath, backend.StreamOptions{}); !errors.Is(err, backend.ErrNotExist) {
+			t.Errorf("Stream(%q) returned %v, want ErrNotExist", logPath, err)
+		}
+	}
+}
+
+func TestStatWriting(t *testing.T) {
+	b, _ := newTestBackend(t, "")
+
+	info, err := b.Stat(context.Background(), testLogPath)
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+
+	// The log of a running container is still being written, however long
+	// ago it started.
+	if !info.Writing || time.Since(info.ModTime) < time.Hour {
+		t.Errorf("Stat returned %+v, want a log being written since the container started", info)
+	}
+}
@@ -0,0 +1,155 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// PodMetadataFile is the name of the file describing an archived pod.
+//
+// Archived logs mirror the hierarchy of the Kubernetes backend, i.e.
+// "<namespace>/<pod>/<container>", with the pod's manifest (as output by
+// `kubectl get pod -o json`) stored alongside its container logs.
+const PodMetadataFile = "pod.json"
+
+// ErrInvalidSelector is returned when a label selector cannot be parsed.
+var ErrInvalidSelector = errors.New("backend: invalid label selector")
+
+// metadataFiles are the names of files within a pod directory which describe
+// the pod, rather than containing a container's logs.
+var metadataFiles = []string{
+	PodMetadataFile,
+	PodEventsFile,
+}
+
+// Pod describes a pod whose container logs are served by a backend.
+type Pod struct {
+	Namespace  string
+	Name       string
+	Containers []string
+}
+
+// ContainerPath returns the path of the named container's log.
+func (p Pod) ContainerPath(container string) string {
+	return path.Join(p.Namespace, p.Name, container)
+}
+
+// PodSelector is implemented by backends which can resolve label selectors to
+// pods directly.
+type PodSelector interface {
+	// SelectPods returns the pods in the namespace matching the label
+	// selector.
+	SelectPods(ctx context.Context, namespace string, selector string) ([]Pod, error)
+}
+
+// SelectPods returns the pods in the namespace matching the label selector.
+//
+// If the backend does not implement [PodSelector], the pods are resolved from
+// the metadata stored alongside archived logs.
+func SelectPods(
+	ctx context.Context,
+	b Backend,
+	namespace string,
+	selector string,
+) ([]Pod, error) {
+	if podSelector, ok := b.(PodSelector); ok {
+		return podSelector.SelectPods(ctx, namespace, selector)
+	}
+
+	parsedSelector, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+	}
+
+	podEntries, err := b.ReadDir(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []Pod
+
+	for _, podEntry := range podEntries {
+		if !podEntry.Dir {
+			continue
+		}
+
+		podPath := path.Join(namespace, podEntry.Name)
+
+		podLabels, err := readPodLabels(ctx, b, podPath)
+		if errors.Is(err, ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if !parsedSelector.Matches(labels.Set(podLabels)) {
+			continue
+		}
+
+		containerEntries, err := b.ReadDir(ctx, podPath)
+		if err != nil {
+			return nil, err
+		}
+
+		pod := Pod{
+			Namespace: namespace,
+			Name:      podEntry.Name,
+		}
+
+		for _, containerEntry := range containerEntries {
+			if containerEntry.Dir || IsMetadataFile(containerEntry.Name) {
+				continue
+			}
+
+			pod.Containers = append(pod.Containers, containerEntry.Name)
+		}
+
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
+
+// IsMetadataFile reports whether the named file within a pod directory
+// describes the pod, rather than containing a container's logs.
+func IsMetadataFile(name string) bool {
+	return slices.Contains(metadataFiles, name)
+}
+
+// readPodLabels reads the labels of the archived pod at the given path.
+func readPodLabels(
+	ctx context.Context,
+	b Backend,
+	podPath string,
+) (map[string]string, error) {
+	file, err := b.Open(ctx, path.Join(podPath, PodMetadataFile))
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	bs, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("backend: reading pod metadata: %w", err)
+	}
+
+	var pod struct {
+		Metadata struct {
+			Labels map[string]string `json:"labels"`
+		} `json:"metadata"`
+	}
+
+	if err := json.Unmarshal(bs, &pod); err != nil {
+		return nil, fmt.Errorf("backend: decoding pod metadata: %w", err)
+	}
+
+	return pod.Metadata.Labels, nil
+}
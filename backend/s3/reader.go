@@ -0,0 +1,164 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// ErrObjectChanged is returned when reading an object which has been
+// overwritten since it was opened, as its bytes would otherwise be a mixture
+// of both versions.
+var ErrObjectChanged = errors.New("s3: object changed while reading")
+
+// errNegativeOffset is returned when seeking before the start of an object.
+var errNegativeOffset = errors.New("s3: negative offset")
+
+// objectReader reads an object using HTTP range requests, fetching a single
+// chunk at a time.
+//
+// ReadAt may be called concurrently, as [io.ReaderAt] permits, while Read and
+// Seek share the offset of the reader, so may not.
+type objectReader struct {
+	ctx       context.Context
+	backend   *Backend
+	key       string
+	size      int64
+	chunkSize int64
+	offset    int64
+
+	// etag is the ETag of the version of the object being read, which every
+	// range must be fetched from.
+	etag string
+
+	// mu guards the chunk, which holds the most recently fetched range,
+	// starting at chunkOffset.
+	mu          sync.Mutex
+	chunk       []byte
+	chunkOffset int64
+}
+
+// Read reads from the current offset, fetching a new chunk when required.
+func (o *objectReader) Read(p []byte) (int, error) {
+	n, err := o.ReadAt(p, o.offset)
+	o.offset += int64(n)
+
+	return n, err
+}
+
+// ReadAt reads len(p) bytes starting at the given offset.
+func (o *objectReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errNegativeOffset
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var read int
+
+	for read < len(p) {
+		if off >= o.size {
+			return read, io.EOF
+		}
+
+		if off < o.chunkOffset || off >= o.chunkOffset+int64(len(o.chunk)) {
+			if err := o.fetch(off); err != nil {
+				return read, err
+			}
+		}
+
+		n := copy(p[read:], o.chunk[off-o.chunkOffset:])
+		read += n
+		off += int64(n)
+	}
+
+	return read, nil
+}
+
+// Seek sets the offset for the next Read.
+func (o *objectReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += o.offset
+	case io.SeekEnd:
+		offset += o.size
+	default:
+		return 0, fmt.Errorf("s3: invalid whence %d", whence)
+	}
+
+	if offset < 0 {
+		return 0, errNegativeOffset
+	}
+
+	o.offset = offset
+
+	return offset, nil
+}
+
+// Close releases the buffered chunk.
+func (o *objectReader) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.chunk = nil
+
+	return nil
+}
+
+// fetch retrieves the chunk starting at the given offset, from the version of
+// the object being read. The caller must hold the lock.
+func (o *objectReader) fetch(off int64) error {
+	end := min(off+o.chunkSize, o.size) - 1
+
+	var opts minio.GetObjectOptions
+
+	if err := opts.SetRange(off, end); err != nil {
+		return fmt.Errorf("s3: setting range: %w", err)
+	}
+
+	if o.etag != "" {
+		if err := opts.SetMatchETag(o.etag); err != nil {
+			return fmt.Errorf("s3: setting etag: %w", err)
+		}
+	}
+
+	object, err := o.backend.client.GetObject(o.ctx, o.backend.bucket, o.key, opts)
+	if err != nil {
+		return fmt.Errorf("s3: getting object range: %w", changedError(err))
+	}
+
+	defer object.Close()
+
+	// The object is requested when it is first read, so a changed object is
+	// reported by the read.
+	chunk, err := io.ReadAll(object)
+	if err != nil {
+		return fmt.Errorf("s3: reading object range: %w", changedError(err))
+	}
+
+	if len(chunk) == 0 {
+		return io.ErrUnexpectedEOF
+	}
+
+	o.chunk = chunk
+	o.chunkOffset = off
+
+	return nil
+}
+
+// changedError returns [ErrObjectChanged] if the error is the failure of the
+// precondition that the object is unchanged, and otherwise the error.
+func changedError(err error) error {
+	if minio.ToErrorResponse(err).StatusCode == http.StatusPreconditionFailed {
+		return ErrObjectChanged
+	}
+
+	return err
+}
@@ -0,0 +1,237 @@
+// Package s3 provides a backend serving logs from an S3-compatible object
+// store.
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/crystalix007/log-viewer/backend"
+)
+
+// defaultChunkSize is the number of bytes fetched by each range request.
+const defaultChunkSize = 256 * 1024
+
+// ErrMissingBucket is returned when no bucket is configured.
+var ErrMissingBucket = errors.New("s3: bucket is required")
+
+// Options configures the connection to the object store.
+type Options struct {
+	// Endpoint is the host (and optional port) of the object store, e.g.
+	// "s3.amazonaws.com" or "localhost:9000".
+	Endpoint string
+
+	// Bucket is the bucket that logs are stored in.
+	Bucket string
+
+	// Prefix is prepended to all object keys, allowing logs to be served
+	// from a subtree of the bucket.
+	Prefix string
+
+	// Region is the region of the bucket, if required by the object store.
+	Region string
+
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+
+	// Insecure disables TLS when connecting to the object store.
+	Insecure bool
+
+	// Transport overrides the HTTP transport used to reach the object store.
+	Transport http.RoundTripper
+
+	// ChunkSize is the number of bytes fetched by each range request.
+	ChunkSize int64
+}
+
+// Backend serves logs from a bucket in an S3-compatible object store.
+//
+// Prefixes are presented as directories, and objects as log files.
+type Backend struct {
+	client    *minio.Client
+	bucket    string
+	prefix    string
+	chunkSize int64
+}
+
+// Ensure that Backend implements the backend.Backend interface.
+var _ backend.Backend = &Backend{}
+
+// New creates a new S3 backend from the given options.
+func New(opts Options) (*Backend, error) {
+	if opts.Bucket == "" {
+		return nil, ErrMissingBucket
+	}
+
+	var creds *credentials.Credentials
+
+	if opts.AccessKeyID != "" || opts.SecretAccessKey != "" {
+		creds = credentials.NewStaticV4(
+			opts.AccessKeyID,
+			opts.SecretAccessKey,
+			opts.SessionToken,
+		)
+	} else {
+		creds = credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.EnvMinio{},
+			&credentials.FileAWSCredentials{},
+			&credentials.IAM{},
+		})
+	}
+
+	client, err := minio.New(opts.Endpoint, &minio.Options{
+		Creds:     creds,
+		Secure:    !opts.Insecure,
+		Region:    opts.Region,
+		Transport: opts.Transport,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("s3: creating client: %w", err)
+	}
+
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+
+	return &Backend{
+		client:    client,
+		bucket:    opts.Bucket,
+		prefix:    strings.Trim(opts.Prefix, "/"),
+		chunkSize: chunkSize,
+	}, nil
+}
+
+// ReadDir lists the prefixes and objects directly beneath the given path.
+func (b *Backend) ReadDir(
+	ctx context.Context,
+	requestPath string,
+) ([]backend.Entry, error) {
+	listPrefix := b.key(requestPath)
+	if listPrefix != "" {
+		listPrefix += "/"
+	}
+
+	var entries []backend.Entry
+
+	for object := range b.client.ListObjects(ctx, b.bucket, minio.ListObjectsOptions{
+		Prefix: listPrefix,
+	}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf("s3: listing objects: %w", object.Err)
+		}
+
+		name := strings.TrimPrefix(object.Key, listPrefix)
+		dir := strings.HasSuffix(name, "/")
+
+		name = strings.TrimSuffix(name, "/")
+		if name == "" {
+			continue
+		}
+
+		entries = append(entries, backend.Entry{
+			Name: name,
+			Dir:  dir,
+		})
+	}
+
+	// Object stores have no real directories, so an empty listing is the only
+	// indication that the prefix does not exist.
+	if len(entries) == 0 && listPrefix != "" {
+		return nil, backend.ErrNotExist
+	}
+
+	return entries, nil
+}
+
+// Stat returns the details of the object at the given path.
+func (b *Backend) Stat(
+	ctx context.Context,
+	requestPath string,
+) (backend.Info, error) {
+	objectInfo, err := b.statObject(ctx, requestPath)
+	if err != nil {
+		return backend.Info{}, err
+	}
+
+	return backend.Info{
+		Name:    path.Base(objectInfo.Key),
+		Size:    objectInfo.Size,
+		ModTime: objectInfo.LastModified,
+	}, nil
+}
+
+// statObject returns the details of the object at the given path.
+func (b *Backend) statObject(ctx context.Context, requestPath string) (minio.ObjectInfo, error) {
+	key := b.key(requestPath)
+	if key == "" {
+		return minio.ObjectInfo{}, backend.ErrNotExist
+	}
+
+	objectInfo, err := b.client.StatObject(ctx, b.bucket, key, minio.StatObjectOptions{})
+	if isNotFound(err) {
+		return minio.ObjectInfo{}, backend.ErrNotExist
+	} else if err != nil {
+		return minio.ObjectInfo{}, fmt.Errorf("s3: stat object: %w", err)
+	}
+
+	objectInfo.Key = key
+
+	return objectInfo, nil
+}
+
+// Open opens the object at the given path for reading.
+//
+// The object is fetched lazily, one range request at a time, so that readers
+// which stop early (e.g. when paging) do not download the whole object. Each
+// range is only fetched from the version of the object that was opened, and
+// reads fail with [ErrObjectChanged] if it has since been overwritten.
+func (b *Backend) Open(
+	ctx context.Context,
+	requestPath string,
+) (io.ReadCloser, error) {
+	objectInfo, err := b.statObject(ctx, requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objectReader{
+		ctx:       ctx,
+		backend:   b,
+		key:       objectInfo.Key,
+		etag:      objectInfo.ETag,
+		size:      objectInfo.Size,
+		chunkSize: b.chunkSize,
+	}, nil
+}
+
+// key converts a request path into an object key.
+func (b *Backend) key(requestPath string) string {
+	// Cleaning a rooted path removes any ".." components that would otherwise
+	// escape the configured prefix.
+	cleanPath := strings.TrimPrefix(path.Clean("/"+requestPath), "/")
+
+	return strings.Trim(path.Join(b.prefix, cleanPath), "/")
+}
+
+// isNotFound reports whether the error indicates a missing object.
+func isNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	response := minio.ToErrorResponse(err)
+
+	return response.StatusCode == http.StatusNotFound ||
+		response.Code == "NoSuchKey"
+}
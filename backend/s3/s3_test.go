@@ -0,0 +1,487 @@
+package s3_test
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crystalix007/log-viewer/backend"
+	"github.com/crystalix007/log-viewer/backend/s3"
+)
+
+const testBucket = "logs"
+
+// fakeS3 is an in-process stand-in for an S3-compatible object store,
+// serving the subset of the API used by the backend: listing objects with a
+// delimiter, HEAD requests and ranged GET requests, which may be conditional
+// on the ETag of the object.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]string
+	ranges  []string
+}
+
+type listBucketResult struct {
+	XMLName        xml.Name       `xml:"ListBucketResult"`
+	Name           string         `xml:"Name"`
+	Prefix         string         `xml:"Prefix"`
+	Delimiter      string         `xml:"Delimiter"`
+	KeyCount       int            `xml:"KeyCount"`
+	MaxKeys        int            `xml:"MaxKeys"`
+	IsTruncated    bool           `xml:"IsTruncated"`
+	Contents       []listObject   `xml:"Contents"`
+	CommonPrefixes []commonPrefix `xml:"CommonPrefixes"`
+}
+
+type listObject struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	Size         int    `xml:"Size"`
+}
+
+type commonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+var testModTime = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != testBucket {
+		f.error(w, http.StatusNotFound, "NoSuchBucket")
+
+		return
+	}
+
+	if key == "" {
+		f.list(w, r)
+
+		return
+	}
+
+	f.mu.Lock()
+	contents, ok := f.objects[key]
+	f.mu.Unlock()
+
+	if !ok {
+		f.error(w, http.StatusNotFound, "NoSuchKey")
+
+		return
+	}
+
+	etag := fmt.Sprintf(`"%x"`, md5.Sum([]byte(contents)))
+
+	if match := r.Header.Get("If-Match"); match != "" && match != etag {
+		f.error(w, http.StatusPreconditionFailed, "PreconditionFailed")
+
+		return
+	}
+
+	w.Header().Set("Last-Modified", testModTime.Format(http.TimeFormat))
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Content-Type", "text/plain")
+
+	if r.Method == http.MethodHead {
+		w.Header().Set("Content-Length", strconv.Itoa(len(contents)))
+
+		return
+	}
+
+	rangeHeader := r.Header.Get("Range")
+
+	f.mu.Lock()
+	f.ranges = append(f.ranges, rangeHeader)
+	f.mu.Unlock()
+
+	var start, end int
+
+	if _, err := fmt.Sscanf(rangeHeader, "bytes=%d-%d", &start, &end); err != nil {
+		f.error(w, http.StatusBadRequest, "InvalidRange")
+
+		return
+	}
+
+	end = min(end, len(contents)-1)
+
+	w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(contents)))
+	w.Header().Set("Content-Length", strconv.Itoa(end-start+1))
+	w.WriteHeader(http.StatusPartialContent)
+	io.WriteString(w, contents[start:end+1])
+}
+
+// list lists the objects and common prefixes beneath the requested prefix,
+// grouping keys by the delimiter.
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	prefix := r.URL.Query().Get("prefix")
+	delimiter := r.URL.Query().Get("delimiter")
+
+	result := listBucketResult{
+		Name:      testBucket,
+		Prefix:    prefix,
+		Delimiter: delimiter,
+		MaxKeys:   1000,
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	keys := make([]string, 0, len(f.objects))
+	for key := range f.objects {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		rest, ok := strings.CutPrefix(key, prefix)
+		if !ok {
+			continue
+		}
+
+		if delimiter != "" {
+			if dir, _, found := strings.Cut(rest, delimiter); found {
+				common := commonPrefix{Prefix: prefix + dir + delimiter}
+				if !slices.Contains(result.CommonPrefixes, common) {
+					result.CommonPrefixes = append(result.CommonPrefixes, common)
+				}
+
+				continue
+			}
+		}
+
+		result.Contents = append(result.Contents, listObject{
+			Key:          key,
+			LastModified: testModTime.Format(time.RFC3339),
+			Size:         len(f.objects[key]),
+		})
+	}
+
+	result.KeyCount = len(result.Contents) + len(result.CommonPrefixes)
+
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeS3) error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+// put creates or overwrites the object with the given key.
+func (f *fakeS3) put(key, contents string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.objects[key] = contents
+}
+
+// requestedRanges returns the Range headers of the GET requests made so far.
+func (f *fakeS3) requestedRanges() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.ranges)
+}
+
+// newTestBackend starts a fake object store holding the given objects, and
+// returns a backend reading from it in chunks of the given size.
+func newTestBackend(t *testing.T, objects map[string]string, chunkSize int64) (*s3.Backend, *fakeS3) {
+	t.Helper()
+
+	fake := &fakeS3{objects: objects}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	b, err := s3.New(s3.Options{
+		Endpoint:        strings.TrimPrefix(server.URL, "http://"),
+		Bucket:          testBucket,
+		Region:          "us-east-1",
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+		Insecure:        true,
+		ChunkSize:       chunkSize,
+	})
+	if err != nil {
+		t.Fatalf("creating backend: %v", err)
+	}
+
+	return b, fake
+}
+
+func TestReadDir(t *testing.T) {
+	b, _ := newTestBackend(t, map[string]string{
+		"app.log":             "a\n",
+		"ns1/pod1/main.log":   "b\n",
+		"ns1/pod1/side.log":   "c\n",
+		"ns1/pod2/main.log":   "d\n",
+		"ns1/summary.log":     "e\n",
+		"ns2/deep/nested.log": "f\n",
+	}, 0)
+
+	tests := []struct {
+		path string
+		want []backend.Entry
+	}{
+		{
+			path: "",
+			want: []backend.Entry{
+				{Name: "app.log"},
+				{Name: "ns1", Dir: true},
+				{Name: "ns2", Dir: true},
+			},
+		},
+		{
+			path: "ns1",
+			want: []backend.Entry{
+				{Name: "summary.log"},
+				{Name: "pod1", Dir: true},
+				{Name: "pod2", Dir: true},
+			},
+		},
+		{
+			path: "ns1/pod1",
+			want: []backend.Entry{
+				{Name: "main.log"},
+				{Name: "side.log"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			entries, err := b.ReadDir(context.Background(), test.path)
+			if err != nil {
+				t.Fatalf("ReadDir(%q): %v", test.path, err)
+			}
+
+			if !slices.Equal(entries, test.want) {
+				t.Errorf("ReadDir(%q) = %v, want %v", test.path, entries, test.want)
+			}
+		})
+	}
+}
+
+func TestNotExist(t *testing.T) {
+	b, _ := newTestBackend(t, map[string]string{
+		"app.log": "a\n",
+	}, 0)
+
+	ctx := context.Background()
+
+	if _, err := b.Stat(ctx, "missing.log"); !errors.Is(err, backend.ErrNotExist) {
+		t.Errorf("Stat of a missing object returned %v, want ErrNotExist", err)
+	}
+
+	if _, err := b.Open(ctx, "missing.log"); !errors.Is(err, backend.ErrNotExist) {
+		t.Errorf("Open of a missing object returned %v, want ErrNotExist", err)
+	}
+
+	if _, err := b.ReadDir(ctx, "missing"); !errors.Is(err, backend.ErrNotExist) {
+		t.Errorf("ReadDir of a missing prefix returned %v, want ErrNotExist", err)
+	}
+
+	info, err := b.Stat(ctx, "app.log")
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+
+	if info.Size != 2 || !info.ModTime.Equal(testModTime) {
+		t.Errorf("Stat = %+v, want a size of 2 modified at %v", info, testModTime)
+	}
+}
+
+func TestLazyRangeReads(t *testing.T) {
+	contents := strings.Repeat("0123456789", 10)
+
+	b, fake := newTestBackend(t, map[string]string{
+		"app.log": contents,
+	}, 16)
+
+	file, err := b.Open(context.Background(), "app.log")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	defer file.Close()
+
+	if ranges := fake.requestedRanges(); len(ranges) != 0 {
+		t.Fatalf("Open fetched %v before reading", ranges)
+	}
+
+	buf := make([]byte, 10)
+
+	if _, err := io.ReadFull(file, buf); err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+
+	if string(buf) != contents[:10] {
+		t.Errorf("read %q, want %q", buf, contents[:10])
+	}
+
+	if ranges, want := fake.requestedRanges(), []string{"bytes=0-15"}; !slices.Equal(ranges, want) {
+		t.Errorf("requested ranges %v, want %v", ranges, want)
+	}
+
+	rest, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("reading the rest: %v", err)
+	}
+
+	if string(rest) != contents[10:] {
+		t.Errorf("read %q, want %q", rest, contents[10:])
+	}
+
+	// The whole object is fetched in chunks, ending with a short one.
+	ranges := fake.requestedRanges()
+	if len(ranges) != 7 || ranges[len(ranges)-1] != "bytes=96-99" {
+		t.Errorf("requested ranges %v, want 7 ending with bytes=96-99", ranges)
+	}
+}
+
+func TestReadMidObject(t *testing.T) {
+	var lines []string
+	for i := range 20 {
+		lines = append(lines, fmt.Sprintf("line %02d", i))
+	}
+
+	contents := strings.Join(lines, "\n") + "\n"
+
+	b, fake := newTestBackend(t, map[string]string{
+		"app.log": contents,
+	}, 32)
+
+	file, err := b.Open(context.Background(), "app.log")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	defer file.Close()
+
+	seeker, ok := file.(io.ReadSeeker)
+	if !ok {
+		t.Fatal("the object reader does not implement io.Seeker")
+	}
+
+	// Each line is 8 bytes, so the page of lines 10-12 starts at byte 80.
+	if _, err := seeker.Seek(80, io.SeekStart); err != nil {
+		t.Fatalf("Seek: %v", err)
+	}
+
+	page := make([]byte, 24)
+
+	if _, err := io.ReadFull(seeker, page); err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+
+	if want := "line 10\nline 11\nline 12\n"; string(page) != want {
+		t.Errorf("read %q, want %q", page, want)
+	}
+
+	if ranges, want := fake.requestedRanges(), []string{"bytes=80-111"}; !slices.Equal(ranges, want) {
+		t.Errorf("requested ranges %v, want %v", ranges, want)
+	}
+
+	readerAt, ok := file.(io.ReaderAt)
+	if !ok {
+		t.Fatal("the object reader does not implement io.ReaderAt")
+	}
+
+	// Reading within the fetched chunk makes no further requests.
+	if _, err := readerAt.ReadAt(page[:8], 104); err != nil {
+		t.Fatalf("ReadAt: %v", err)
+	}
+
+	if string(page[:8]) != "line 13\n" {
+		t.Errorf("ReadAt read %q, want %q", page[:8], "line 13\n")
+	}
+
+	if ranges := fake.requestedRanges(); len(ranges) != 1 {
+		t.Errorf("requested ranges %v, want a single range", ranges)
+	}
+}
+
+func TestReadOverwrittenObject(t *testing.T) {
+	contents := strings.Repeat("0123456789", 10)
+
+	b, fake := newTestBackend(t, map[string]string{
+		"app.log": contents,
+	}, 16)
+
+	file, err := b.Open(context.Background(), "app.log")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	defer file.Close()
+
+	buf := make([]byte, 16)
+
+	if _, err := io.ReadFull(file, buf); err != nil {
+		t.Fatalf("reading: %v", err)
+	}
+
+	// Overwriting the object means its later chunks would come from a
+	// different version, so reading them fails rather than mixing the two.
+	fake.put("app.log", strings.Repeat("abcdefghij", 10))
+
+	if _, err := io.ReadFull(file, buf); !errors.Is(err, s3.ErrObjectChanged) {
+		t.Errorf("reading an overwritten object returned %v, want ErrObjectChanged", err)
+	}
+}
+
+func TestConcurrentReadAt(t *testing.T) {
+	var lines []string
+	for i := range 100 {
+		lines = append(lines, fmt.Sprintf("line %02d", i))
+	}
+
+	contents := strings.Join(lines, "\n") + "\n"
+
+	b, _ := newTestBackend(t, map[string]string{
+		"app.log": contents,
+	}, 32)
+
+	file, err := b.Open(context.Background(), "app.log")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	defer file.Close()
+
+	readerAt, ok := file.(io.ReaderAt)
+	if !ok {
+		t.Fatal("the object reader does not implement io.ReaderAt")
+	}
+
+	var wg sync.WaitGroup
+
+	for i := range lines {
+		wg.Go(func() {
+			line := make([]byte, 8)
+
+			if _, err := readerAt.ReadAt(line, int64(i)*8); err != nil {
+				t.Errorf("ReadAt line %d: %v", i, err)
+
+				return
+			}
+
+			if want := lines[i] + "\n"; string(line) != want {
+				t.Errorf("ReadAt line %d read %q, want %q", i, line, want)
+			}
+		})
+	}
+
+	wg.Wait()
+}
@@ -1,13 +1,23 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
+	"os"
+	"strings"
 
 	"github.com/crystalix007/log-viewer/api"
+	"github.com/crystalix007/log-viewer/audit"
+	"github.com/crystalix007/log-viewer/authz"
+	"github.com/crystalix007/log-viewer/backend/filesystem"
+	"github.com/crystalix007/log-viewer/backend/kubernetes"
+	"github.com/crystalix007/log-viewer/backend/s3"
+	"github.com/crystalix007/log-viewer/middleware"
+	"github.com/crystalix007/log-viewer/redact"
 	"github.com/spf13/cobra"
 )
 
@@ -15,8 +25,81 @@ import (
 type Flags struct {
 	Address          *string
 	WorkingDirectory *string
+	Roots            *[]string
+	Symlinks         *string
+	SymlinkTargets   *[]string
+	S3               S3Flags
+	Kubernetes       KubernetesFlags
+	Auth             AuthFlags
+	AuthzPolicy      *string
+	Redact           RedactFlags
+	Audit            AuditFlags
+	StripANSI        *bool
+	StateDir         *string
+	TemplateDir      *string
+	ReloadTemplates  *bool
 }
 
+// AuditFlags represents the command-line flags configuring the audit log.
+type AuditFlags struct {
+	Log        *string
+	MaxSize    *int64
+	MaxBackups *int
+	Retain     *int
+}
+
+// RedactFlags represents the command-line flags configuring redaction.
+type RedactFlags struct {
+	Detectors *[]string
+	Patterns  *[]string
+	Fields    *[]string
+}
+
+// S3Flags represents the command-line flags configuring the S3 backend.
+type S3Flags struct {
+	Root            *string
+	Endpoint        *string
+	Bucket          *string
+	Prefix          *string
+	Region          *string
+	AccessKeyID     *string
+	SecretAccessKey *string
+	SessionToken    *string
+	Insecure        *bool
+}
+
+// KubernetesFlags represents the command-line flags configuring the
+// Kubernetes backend.
+type KubernetesFlags struct {
+	Root       *string
+	Enabled    *bool
+	Kubeconfig *string
+	Context    *string
+}
+
+// AuthFlags represents the command-line flags configuring authentication.
+type AuthFlags struct {
+	TokenFile    *string
+	HtpasswdFile *string
+	SessionKey   *string
+	OIDC         OIDCFlags
+}
+
+// OIDCFlags represents the command-line flags configuring OIDC login.
+type OIDCFlags struct {
+	Issuer        *string
+	ClientID      *string
+	ClientSecret  *string
+	RedirectURL   *string
+	Scopes        *[]string
+	UsernameClaim *string
+	GroupsClaim   *string
+}
+
+// ErrInvalidRoot is returned when a root flag is not of the form
+// "name=directory".
+var ErrInvalidRoot = errors.New("root must be of the form name=directory")
+
 func main() {
 	var flags Flags
 
@@ -30,7 +113,130 @@ func main() {
 
 	flags.Address = cmd.Flags().StringP("address", "a", "localhost:0", "the address to listen on")
 	flags.WorkingDirectory = cmd.Flags().
-		StringP("working-directory", "w", "", "the working directory for the API")
+		StringP("working-directory", "w", "", "the working directory for the API, served as the default root")
+	flags.Roots = cmd.Flags().
+		StringArrayP("root", "r", nil, "a named root of the form name=directory (may be repeated)")
+
+	flags.Symlinks = cmd.Flags().String(
+		"symlinks",
+		filesystem.SymlinkAllowWithinRoot.String(),
+		"the policy for following symlinks: deny, allow-within-root or allow-list",
+	)
+	flags.SymlinkTargets = cmd.Flags().StringArray(
+		"symlink-target",
+		nil,
+		"a directory outside the roots that symlinks may point into, with --symlinks=allow-list (may be repeated)",
+	)
+
+	flags.S3.Root = cmd.Flags().
+		String("s3-root", "s3", "the name of the root serving the S3 bucket")
+	flags.S3.Endpoint = cmd.Flags().
+		String("s3-endpoint", "s3.amazonaws.com", "the endpoint of the S3-compatible object store")
+	flags.S3.Bucket = cmd.Flags().
+		String("s3-bucket", "", "the bucket to serve logs from")
+	flags.S3.Prefix = cmd.Flags().
+		String("s3-prefix", "", "the key prefix to serve logs from within the bucket")
+	flags.S3.Region = cmd.Flags().String("s3-region", "", "the region of the bucket")
+	flags.S3.AccessKeyID = cmd.Flags().
+		String("s3-access-key-id", "", "the access key ID (defaults to the environment)")
+	flags.S3.SecretAccessKey = cmd.Flags().
+		String("s3-secret-access-key", "", "the secret access key (defaults to the environment)")
+	flags.S3.SessionToken = cmd.Flags().String("s3-session-token", "", "the session token")
+	flags.S3.Insecure = cmd.Flags().
+		Bool("s3-insecure", false, "connect to the object store without TLS")
+
+	flags.Kubernetes.Root = cmd.Flags().
+		String("kubernetes-root", "kubernetes", "the name of the root serving the Kubernetes API server")
+	flags.Kubernetes.Enabled = cmd.Flags().
+		Bool("kubernetes", false, "serve live logs from the Kubernetes API server")
+	flags.Kubernetes.Kubeconfig = cmd.Flags().
+		String("kubeconfig", "", "the kubeconfig file (defaults to the standard locations or in-cluster config)")
+	flags.Kubernetes.Context = cmd.Flags().
+		String("kube-context", "", "the kubeconfig context to use (defaults to the current context)")
+
+	flags.Auth.TokenFile = cmd.Flags().String(
+		"auth-token-file",
+		"",
+		"a CSV file of bearer tokens, as token,user,uid,\"group1,group2\"",
+	)
+	flags.Auth.HtpasswdFile = cmd.Flags().
+		String("auth-htpasswd-file", "", "an htpasswd file of users allowed to log in with basic auth")
+	flags.Auth.SessionKey = cmd.Flags().String(
+		"session-key",
+		"",
+		"the key signing session cookies (defaults to a random key, ending sessions on restart)",
+	)
+	flags.Auth.OIDC.Issuer = cmd.Flags().
+		String("oidc-issuer", "", "the issuer URL of the OIDC identity provider to log in with")
+	flags.Auth.OIDC.ClientID = cmd.Flags().String("oidc-client-id", "", "the OIDC client ID")
+	flags.Auth.OIDC.ClientSecret = cmd.Flags().String("oidc-client-secret", "", "the OIDC client secret")
+	flags.Auth.OIDC.RedirectURL = cmd.Flags().String(
+		"oidc-redirect-url",
+		"",
+		"the URL of the OIDC callback, e.g. https://logs.example.com/auth/oidc/callback",
+	)
+	flags.Auth.OIDC.Scopes = cmd.Flags().
+		StringSlice("oidc-scopes", []string{"profile", "email"}, "the OIDC scopes to request, in addition to openid")
+	flags.Auth.OIDC.UsernameClaim = cmd.Flags().
+		String("oidc-username-claim", "email", "the ID token claim holding the username")
+	flags.Auth.OIDC.GroupsClaim = cmd.Flags().
+		String("oidc-groups-claim", "groups", "the ID token claim holding the user's groups")
+
+	flags.AuthzPolicy = cmd.Flags().String(
+		"authz-policy",
+		"",
+		"a YAML file of rules restricting the logs each user or group may view",
+	)
+
+	flags.Redact.Detectors = cmd.Flags().StringSlice(
+		"redact-detectors",
+		nil,
+		"the built-in detectors of secrets and personal data to redact, or all: "+
+			strings.Join(redact.DetectorNames(), ", "),
+	)
+	flags.Redact.Patterns = cmd.Flags().StringArray(
+		"redact-pattern",
+		nil,
+		"a regular expression to redact, or its (?P<secret>...) group if present (may be repeated)",
+	)
+	flags.Redact.Fields = cmd.Flags().
+		StringSlice("redact-fields", nil, "the JSON fields whose values are redacted")
+
+	flags.Audit.Log = cmd.Flags().String(
+		"audit-log",
+		"",
+		"the JSON lines file recording who viewed which logs, or - for standard output",
+	)
+	flags.Audit.MaxSize = cmd.Flags().
+		Int64("audit-max-size", 100, "the size in MiB at which the audit log file is rotated")
+	flags.Audit.MaxBackups = cmd.Flags().
+		Int("audit-max-backups", 10, "the number of rotated audit log files to keep")
+	flags.Audit.Retain = cmd.Flags().Int(
+		"audit-retain",
+		0,
+		"the number of recent audit records served to users granted view-audit at /api/audit",
+	)
+
+	flags.StripANSI = cmd.Flags().Bool(
+		"strip-ansi",
+		false,
+		"remove ANSI escape sequences, such as colours, from the contents of logs by default",
+	)
+
+	flags.StateDir = cmd.Flags().String(
+		"state-dir",
+		"",
+		"the directory storing the state of the viewer, such as annotations, outside of any root "+
+			"(defaults to $XDG_STATE_HOME/log-viewer or ~/.local/state/log-viewer)",
+	)
+
+	flags.TemplateDir = cmd.Flags().String(
+		"template-dir",
+		"",
+		"a directory of templates overriding the embedded templates of the same name",
+	)
+	flags.ReloadTemplates = cmd.Flags().
+		Bool("reload-templates", false, "reload templates in --template-dir when they change (for development)")
 
 	if err := cmd.Execute(); err != nil {
 		panic(err)
@@ -40,29 +246,177 @@ func main() {
 func serve(flags Flags) error {
 	var apiOpts []api.Option
 
-	if *flags.WorkingDirectory != "" {
+	symlinkPolicy, err := filesystem.ParseSymlinkPolicy(*flags.Symlinks)
+	if err != nil {
+		return err
+	}
+
+	filesystemOpts := []filesystem.Option{
+		filesystem.WithSymlinkPolicy(symlinkPolicy),
+		filesystem.WithSymlinkTargets(*flags.SymlinkTargets...),
+	}
+
+	for _, rootFlag := range *flags.Roots {
+		name, directory, ok := strings.Cut(rootFlag, "=")
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrInvalidRoot, rootFlag)
+		}
+
+		apiOpts = append(apiOpts, api.WithRoot(name, filesystem.New(directory, filesystemOpts...)))
+
+		slog.Info(
+			"Root added",
+			slog.String("root", name),
+			slog.String("directory", directory),
+		)
+	}
+
+	if *flags.S3.Bucket != "" {
+		s3Backend, err := s3.New(s3.Options{
+			Endpoint:        *flags.S3.Endpoint,
+			Bucket:          *flags.S3.Bucket,
+			Prefix:          *flags.S3.Prefix,
+			Region:          *flags.S3.Region,
+			AccessKeyID:     *flags.S3.AccessKeyID,
+			SecretAccessKey: *flags.S3.SecretAccessKey,
+			SessionToken:    *flags.S3.SessionToken,
+			Insecure:        *flags.S3.Insecure,
+		})
+		if err != nil {
+			return fmt.Errorf("creating S3 backend: %w", err)
+		}
+
+		apiOpts = append(apiOpts, api.WithRoot(*flags.S3.Root, s3Backend))
+
+		slog.Info(
+			"S3 root added",
+			slog.String("root", *flags.S3.Root),
+			slog.String("endpoint", *flags.S3.Endpoint),
+			slog.String("bucket", *flags.S3.Bucket),
+			slog.String("prefix", *flags.S3.Prefix),
+		)
+	}
+
+	if *flags.Kubernetes.Enabled {
+		config, err := kubernetes.LoadConfig(*flags.Kubernetes.Kubeconfig, *flags.Kubernetes.Context)
+		if err != nil {
+			return fmt.Errorf("loading Kubernetes config: %w", err)
+		}
+
+		kubernetesBackend, err := kubernetes.New(config)
+		if err != nil {
+			return fmt.Errorf("creating Kubernetes backend: %w", err)
+		}
+
+		apiOpts = append(apiOpts, api.WithRoot(*flags.Kubernetes.Root, kubernetesBackend))
+
+		slog.Info(
+			"Kubernetes root added",
+			slog.String("root", *flags.Kubernetes.Root),
+			slog.String("host", config.Host),
+		)
+	}
+
+	workingDirectory := *flags.WorkingDirectory
+
+	// The current directory is only served by default if no other roots are
+	// configured.
+	if workingDirectory == "" && len(apiOpts) == 0 {
+		workingDirectory, err = os.Getwd()
+		if err != nil {
+			return fmt.Errorf("getting working directory: %w", err)
+		}
+	}
+
+	if workingDirectory != "" {
 		apiOpts = append(
 			apiOpts,
-			api.WithWorkingDirectory(*flags.WorkingDirectory),
+			api.WithBackend(filesystem.New(workingDirectory, filesystemOpts...)),
 		)
 
 		slog.Info(
 			"Working directory set",
-			slog.String("working_directory", *flags.WorkingDirectory),
+			slog.String("working_directory", workingDirectory),
+		)
+	}
+
+	authenticators, err := authenticators(flags.Auth)
+	if err != nil {
+		return err
+	}
+
+	if len(authenticators) > 0 {
+		apiOpts = append(apiOpts, api.WithAuthentication(authenticators...))
+	}
+
+	if *flags.AuthzPolicy != "" {
+		policy, err := authz.Load(*flags.AuthzPolicy)
+		if err != nil {
+			return fmt.Errorf("loading authorization policy: %w", err)
+		}
+
+		apiOpts = append(apiOpts, api.WithAuthorization(policy))
+
+		slog.Info(
+			"Authorization policy loaded",
+			slog.String("policy", *flags.AuthzPolicy),
+			slog.Int("rules", len(policy.Rules)),
 		)
 	}
 
+	redactor, err := redactor(flags.Redact)
+	if err != nil {
+		return err
+	}
+
+	if redactor != nil {
+		apiOpts = append(apiOpts, api.WithRedaction(redactor))
+	}
+
+	if *flags.Audit.Log != "" {
+		auditLog, err := auditLog(flags.Audit)
+		if err != nil {
+			return err
+		}
+
+		defer auditLog.Close()
+
+		apiOpts = append(apiOpts, api.WithAuditLog(auditLog))
+	}
+
+	if *flags.StripANSI {
+		apiOpts = append(apiOpts, api.WithANSIStripping())
+	}
+
+	apiOpts = append(apiOpts, api.WithStateDirectory(*flags.StateDir))
+
+	if *flags.TemplateDir != "" {
+		apiOpts = append(apiOpts, api.WithTemplateDirectory(*flags.TemplateDir))
+
+		slog.Info(
+			"Template directory set",
+			slog.String("template_dir", *flags.TemplateDir),
+		)
+	}
+
+	if *flags.ReloadTemplates {
+		apiOpts = append(apiOpts, api.WithTemplateReload())
+	}
+
 	api, err := api.New(apiOpts...)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("creating API: %w", err)
 	}
 
+	defer api.Close()
+
 	listener, err := net.Listen("tcp", *flags.Address)
 	if err != nil {
 		panic(err)
 	}
 
-	fmt.Printf("Listening on http://%s\n", listener.Addr().String())
+	// Standard output is left to the audit log, if it is written there.
+	fmt.Fprintf(os.Stderr, "Listening on http://%s\n", listener.Addr().String())
 
 	defer listener.Close()
 
@@ -72,3 +426,106 @@ func serve(flags Flags) error {
 
 	return nil
 }
+
+// authenticators creates the authenticators configured by the flags.
+func authenticators(flags AuthFlags) ([]middleware.Authenticator, error) {
+	var authenticators []middleware.Authenticator
+
+	if *flags.TokenFile != "" {
+		tokenAuthenticator, err := middleware.LoadTokenFile(*flags.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading token file: %w", err)
+		}
+
+		authenticators = append(authenticators, tokenAuthenticator)
+
+		slog.Info("Token authentication enabled")
+	}
+
+	if *flags.HtpasswdFile != "" {
+		basicAuthenticator, err := middleware.LoadHtpasswd(*flags.HtpasswdFile, "Kubernetes Logs")
+		if err != nil {
+			return nil, fmt.Errorf("loading htpasswd file: %w", err)
+		}
+
+		authenticators = append(authenticators, basicAuthenticator)
+
+		slog.Info("Basic authentication enabled")
+	}
+
+	if *flags.OIDC.Issuer != "" {
+		oidcAuthenticator, err := middleware.NewOIDCAuthenticator(
+			context.Background(),
+			middleware.OIDCConfig{
+				IssuerURL:     *flags.OIDC.Issuer,
+				ClientID:      *flags.OIDC.ClientID,
+				ClientSecret:  *flags.OIDC.ClientSecret,
+				RedirectURL:   *flags.OIDC.RedirectURL,
+				Scopes:        *flags.OIDC.Scopes,
+				UsernameClaim: *flags.OIDC.UsernameClaim,
+				GroupsClaim:   *flags.OIDC.GroupsClaim,
+				SessionKey:    []byte(*flags.SessionKey),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("creating OIDC authenticator: %w", err)
+		}
+
+		authenticators = append(authenticators, oidcAuthenticator)
+
+		slog.Info(
+			"OIDC authentication enabled",
+			slog.String("issuer", *flags.OIDC.Issuer),
+		)
+	}
+
+	return authenticators, nil
+}
+
+// redactor creates the redactor configured by the flags, or nil if redaction
+// is disabled.
+func redactor(flags RedactFlags) (*redact.Redactor, error) {
+	detectors, err := redact.Builtin(*flags.Detectors...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range *flags.Patterns {
+		detector, err := redact.PatternDetector(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		detectors = append(detectors, detector)
+	}
+
+	if len(*flags.Fields) > 0 {
+		detectors = append(detectors, redact.FieldDetector(*flags.Fields...))
+	}
+
+	if len(detectors) == 0 {
+		return nil, nil
+	}
+
+	slog.Info("Redaction enabled", slog.Int("detectors", len(detectors)))
+
+	return redact.New(detectors...), nil
+}
+
+// auditLog opens the audit log configured by the flags.
+func auditLog(flags AuditFlags) (*audit.Log, error) {
+	if *flags.Log == "-" {
+		slog.Info("Audit log enabled", slog.String("audit_log", "stdout"))
+
+		return audit.New(os.Stdout, *flags.Retain), nil
+	}
+
+	file, err := audit.OpenRotatingFile(*flags.Log, *flags.MaxSize<<20, *flags.MaxBackups)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+
+	slog.Info("Audit log enabled", slog.String("audit_log", *flags.Log))
+
+	return audit.New(file, *flags.Retain), nil
+}
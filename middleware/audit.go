@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"path"
+	"slices"
+	"time"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+
+	"github.com/crystalix007/log-viewer/audit"
+)
+
+// Audit records every request to the given paths, which read log content, in
+// the audit log.
+//
+// The user is taken from the request context, so the middleware must follow
+// [Authentication].
+func Audit(log *audit.Log, paths ...string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !slices.Contains(paths, path.Clean(r.URL.Path)) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			start := time.Now()
+			ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r)
+
+			user, _ := UserFromContext(r.Context())
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			if err := log.Write(audit.Record{
+				Time:     start,
+				User:     user.Name,
+				Groups:   user.Groups,
+				Endpoint: r.Method + " " + path.Clean(r.URL.Path),
+				Path:     r.URL.Query().Get("path"),
+				Query:    r.URL.RawQuery,
+				Range:    r.Header.Get("Range"),
+				Bytes:    int64(ww.BytesWritten()),
+				Status:   status,
+			}); err != nil {
+				slog.ErrorContext(
+					r.Context(),
+					"failed to write audit record",
+					slog.Any("error", err),
+				)
+			}
+		})
+	}
+}
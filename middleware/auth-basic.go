@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrUnsupportedHash is returned when an htpasswd file contains a password
+// hash in an unsupported format.
+var ErrUnsupportedHash = errors.New("middleware: unsupported htpasswd hash")
+
+// BasicAuthenticator authenticates requests using HTTP basic authentication,
+// checking passwords against an htpasswd file.
+type BasicAuthenticator struct {
+	realm  string
+	hashes map[string]string
+}
+
+// Ensure that BasicAuthenticator implements the Authenticator and Challenger
+// interfaces.
+var (
+	_ Authenticator = &BasicAuthenticator{}
+	_ Challenger    = &BasicAuthenticator{}
+)
+
+// LoadHtpasswd loads an htpasswd file, supporting bcrypt ("htpasswd -B") and
+// SHA-1 ("htpasswd -s") hashes.
+func LoadHtpasswd(filename string, realm string) (*BasicAuthenticator, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("middleware: opening htpasswd file: %w", err)
+	}
+
+	defer file.Close()
+
+	hashes := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		user, hash, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("middleware: invalid htpasswd line for %q", user)
+		}
+
+		if !isBcryptHash(hash) && !strings.HasPrefix(hash, "{SHA}") {
+			return nil, fmt.Errorf("%w: for user %q", ErrUnsupportedHash, user)
+		}
+
+		hashes[user] = hash
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("middleware: reading htpasswd file: %w", err)
+	}
+
+	return &BasicAuthenticator{
+		realm:  realm,
+		hashes: hashes,
+	}, nil
+}
+
+// Authenticate checks the request's basic authentication credentials.
+func (b *BasicAuthenticator) Authenticate(r *http.Request) (User, error) {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return User{}, ErrNoCredentials
+	}
+
+	hash, ok := b.hashes[username]
+	if !ok || !checkPassword(hash, password) {
+		return User{}, fmt.Errorf("%w: incorrect password for %q", ErrInvalidCredentials, username)
+	}
+
+	return User{
+		Name: username,
+	}, nil
+}
+
+// Challenge advertises basic authentication.
+func (b *BasicAuthenticator) Challenge(w http.ResponseWriter, r *http.Request) bool {
+	w.Header().Add("WWW-Authenticate", fmt.Sprintf("Basic realm=%q, charset=\"UTF-8\"", b.realm))
+
+	return false
+}
+
+// isBcryptHash reports whether the hash is in bcrypt format.
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") ||
+		strings.HasPrefix(hash, "$2b$") ||
+		strings.HasPrefix(hash, "$2y$")
+}
+
+// checkPassword reports whether the password matches the htpasswd hash.
+func checkPassword(hash string, password string) bool {
+	if isBcryptHash(hash) {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+
+	sum := sha1.Sum([]byte(password))
+	expected := "{SHA}" + base64.StdEncoding.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(expected)) == 1
+}
@@ -0,0 +1,361 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// OIDCLoginPath is the path prefix of the OIDC login endpoints.
+const OIDCLoginPath = "/auth/oidc/"
+
+const (
+	// oidcSessionCookie is the name of the cookie holding the session of a
+	// user logged in with OIDC.
+	oidcSessionCookie = "log_viewer_session"
+
+	// oidcStateCookie is the name of the cookie holding the state of an
+	// in-progress login.
+	oidcStateCookie = "log_viewer_oidc_state"
+
+	// oidcStateDuration is the length of time a user has to log in with the
+	// identity provider.
+	oidcStateDuration = 10 * time.Minute
+)
+
+var (
+	// ErrMissingIDToken is returned when the identity provider does not
+	// return an ID token.
+	ErrMissingIDToken = errors.New("middleware: no ID token in token response")
+
+	// ErrInvalidState is returned when the state of a login callback does not
+	// match the state of the login.
+	ErrInvalidState = errors.New("middleware: invalid OIDC state")
+
+	// ErrMissingClaim is returned when the ID token lacks the username claim.
+	ErrMissingClaim = errors.New("middleware: missing username claim")
+)
+
+// OIDCConfig configures login with an OpenID Connect identity provider.
+type OIDCConfig struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURL is the URL of the callback endpoint, as registered with the
+	// identity provider, e.g. "https://logs.example.com/auth/oidc/callback".
+	RedirectURL string
+
+	// Scopes are requested in addition to "openid".
+	Scopes []string
+
+	// UsernameClaim is the ID token claim holding the user's name, defaulting
+	// to "email".
+	UsernameClaim string
+
+	// GroupsClaim is the ID token claim holding the user's groups, defaulting
+	// to "groups".
+	GroupsClaim string
+
+	// SessionKey signs session cookies. If empty, a random key is used.
+	SessionKey []byte
+
+	// SessionDuration is the length of time a login lasts.
+	SessionDuration time.Duration
+
+	// HTTPClient overrides the client used to reach the identity provider.
+	HTTPClient *http.Client
+}
+
+// OIDCAuthenticator authenticates users who have logged in with an OpenID
+// Connect identity provider, tracking them with session cookies.
+type OIDCAuthenticator struct {
+	config   OIDCConfig
+	oauth2   oauth2.Config
+	verifier *oidc.IDTokenVerifier
+	sessions *Sessions
+}
+
+// Ensure that OIDCAuthenticator implements the Authenticator, Challenger and
+// LoginHandler interfaces.
+var (
+	_ Authenticator = &OIDCAuthenticator{}
+	_ Challenger    = &OIDCAuthenticator{}
+	_ LoginHandler  = &OIDCAuthenticator{}
+)
+
+// oidcState is the signed contents of the state cookie.
+type oidcState struct {
+	State    string `json:"state"`
+	Redirect string `json:"redirect"`
+}
+
+// NewOIDCAuthenticator creates a new authenticator, discovering the identity
+// provider's configuration from its issuer URL.
+func NewOIDCAuthenticator(ctx context.Context, config OIDCConfig) (*OIDCAuthenticator, error) {
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = "email"
+	}
+
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = "groups"
+	}
+
+	ctx = config.clientContext(ctx)
+
+	provider, err := oidc.NewProvider(ctx, config.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("middleware: discovering OIDC provider: %w", err)
+	}
+
+	sessions, err := NewSessions(oidcSessionCookie, config.SessionKey, config.SessionDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OIDCAuthenticator{
+		config: config,
+		oauth2: oauth2.Config{
+			ClientID:     config.ClientID,
+			ClientSecret: config.ClientSecret,
+			RedirectURL:  config.RedirectURL,
+			Endpoint:     provider.Endpoint(),
+			Scopes:       append([]string{oidc.ScopeOpenID}, config.Scopes...),
+		},
+		verifier: provider.VerifierContext(ctx, &oidc.Config{
+			ClientID: config.ClientID,
+		}),
+		sessions: sessions,
+	}, nil
+}
+
+// Authenticate returns the user of the request's bearer ID token, as issued by
+// the identity provider to clients of the API, or otherwise of its session.
+func (o *OIDCAuthenticator) Authenticate(r *http.Request) (User, error) {
+	rawIDToken, ok := bearerToken(r)
+	if !ok {
+		return o.sessions.Get(r)
+	}
+
+	idToken, err := o.verifier.Verify(o.config.clientContext(r.Context()), rawIDToken)
+	if err != nil {
+		return User{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	return o.tokenUser(idToken)
+}
+
+// Challenge redirects browsers to log in, returning to the requested page
+// afterwards. API requests are left to be rejected as unauthorized, advertising
+// bearer ID tokens.
+func (o *OIDCAuthenticator) Challenge(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet || strings.HasPrefix(r.URL.Path, "/api") {
+		if !slices.Contains(w.Header().Values("WWW-Authenticate"), "Bearer") {
+			w.Header().Add("WWW-Authenticate", "Bearer")
+		}
+
+		return false
+	}
+
+	loginURL := url.URL{
+		Path:     OIDCLoginPath + "login",
+		RawQuery: url.Values{"redirect": {r.URL.RequestURI()}}.Encode(),
+	}
+
+	http.Redirect(w, r, loginURL.String(), http.StatusFound)
+
+	return true
+}
+
+// LoginPath returns the path prefix of the OIDC login endpoints.
+func (o *OIDCAuthenticator) LoginPath() string {
+	return OIDCLoginPath
+}
+
+// ServeHTTP serves the login, callback and logout endpoints.
+func (o *OIDCAuthenticator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch strings.TrimPrefix(r.URL.Path, OIDCLoginPath) {
+	case "login":
+		o.login(w, r)
+	case "callback":
+		o.callback(w, r)
+	case "logout":
+		o.sessions.Clear(w, r)
+		http.Redirect(w, r, "/", http.StatusFound)
+	default:
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	}
+}
+
+// login redirects to the identity provider, remembering where to return to.
+func (o *OIDCAuthenticator) login(w http.ResponseWriter, r *http.Request) {
+	stateBytes := make([]byte, 32)
+
+	if _, err := rand.Read(stateBytes); err != nil {
+		http.Error(w, "Failed to start login", http.StatusInternalServerError)
+
+		return
+	}
+
+	state := oidcState{
+		State:    base64.RawURLEncoding.EncodeToString(stateBytes),
+		Redirect: safeRedirect(r.URL.Query().Get("redirect")),
+	}
+
+	if err := o.sessions.setCookie(
+		w,
+		r,
+		oidcStateCookie,
+		state,
+		time.Now().Add(oidcStateDuration),
+	); err != nil {
+		http.Error(w, "Failed to start login", http.StatusInternalServerError)
+
+		return
+	}
+
+	http.Redirect(
+		w,
+		r,
+		o.oauth2.AuthCodeURL(state.State, oidc.Nonce(state.State)),
+		http.StatusFound,
+	)
+}
+
+// callback completes a login, exchanging the authorization code for an ID
+// token and starting a session for its user.
+func (o *OIDCAuthenticator) callback(w http.ResponseWriter, r *http.Request) {
+	user, redirect, err := o.exchange(r)
+	if err != nil {
+		slog.InfoContext(
+			r.Context(),
+			"OIDC login failed",
+			slog.Any("error", err),
+		)
+
+		http.Error(w, "Login failed", http.StatusUnauthorized)
+
+		return
+	}
+
+	clearCookie(w, r, oidcStateCookie)
+
+	if err := o.sessions.Set(w, r, user); err != nil {
+		http.Error(w, "Failed to start session", http.StatusInternalServerError)
+
+		return
+	}
+
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// exchange validates the login callback, returning the logged in user and the
+// page to return them to.
+func (o *OIDCAuthenticator) exchange(r *http.Request) (User, string, error) {
+	stateCookie, err := r.Cookie(oidcStateCookie)
+	if err != nil {
+		return User{}, "", fmt.Errorf("%w: missing state cookie", ErrInvalidState)
+	}
+
+	var state oidcState
+
+	if err := o.sessions.verify(stateCookie.Value, &state); err != nil {
+		return User{}, "", fmt.Errorf("%w: %w", ErrInvalidState, err)
+	}
+
+	if r.URL.Query().Get("state") != state.State {
+		return User{}, "", ErrInvalidState
+	}
+
+	if errorCode := r.URL.Query().Get("error"); errorCode != "" {
+		return User{}, "", fmt.Errorf("middleware: identity provider error: %s", errorCode)
+	}
+
+	ctx := o.config.clientContext(r.Context())
+
+	token, err := o.oauth2.Exchange(ctx, r.URL.Query().Get("code"))
+	if err != nil {
+		return User{}, "", fmt.Errorf("middleware: exchanging code: %w", err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return User{}, "", ErrMissingIDToken
+	}
+
+	idToken, err := o.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return User{}, "", fmt.Errorf("middleware: verifying ID token: %w", err)
+	}
+
+	if idToken.Nonce != state.State {
+		return User{}, "", fmt.Errorf("%w: nonce mismatch", ErrInvalidState)
+	}
+
+	user, err := o.tokenUser(idToken)
+	if err != nil {
+		return User{}, "", err
+	}
+
+	return user, state.Redirect, nil
+}
+
+// tokenUser returns the user named by the claims of a verified ID token.
+func (o *OIDCAuthenticator) tokenUser(idToken *oidc.IDToken) (User, error) {
+	var claims map[string]any
+
+	if err := idToken.Claims(&claims); err != nil {
+		return User{}, fmt.Errorf("middleware: decoding claims: %w", err)
+	}
+
+	username, _ := claims[o.config.UsernameClaim].(string)
+	if username == "" {
+		return User{}, fmt.Errorf("%w: %q", ErrMissingClaim, o.config.UsernameClaim)
+	}
+
+	user := User{
+		Name: username,
+	}
+
+	groups, _ := claims[o.config.GroupsClaim].([]any)
+
+	for _, group := range groups {
+		if group, ok := group.(string); ok {
+			user.Groups = append(user.Groups, group)
+		}
+	}
+
+	return user, nil
+}
+
+// clientContext returns a context carrying the configured HTTP client, for
+// use by the OIDC and OAuth 2 libraries.
+func (c OIDCConfig) clientContext(ctx context.Context) context.Context {
+	if c.HTTPClient == nil {
+		return ctx
+	}
+
+	return oidc.ClientContext(ctx, c.HTTPClient)
+}
+
+// safeRedirect ensures that a redirect target is a local path, preventing
+// open redirects.
+func safeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") ||
+		strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+
+	return redirect
+}
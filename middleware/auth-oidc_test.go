@@ -0,0 +1,309 @@
+package middleware_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crystalix007/log-viewer/middleware"
+)
+
+const (
+	testClientID = "log-viewer"
+	testKeyID    = "test-key"
+)
+
+// fakeIssuer is an in-process OpenID Connect identity provider, serving its
+// discovery document, signing keys and token endpoint. The token endpoint
+// issues an ID token for the configured claims, with the nonce of the last
+// login.
+type fakeIssuer struct {
+	*httptest.Server
+
+	key *rsa.PrivateKey
+
+	mu     sync.Mutex
+	nonce  string
+	claims map[string]any
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := &fakeIssuer{key: key}
+	issuer.Server = httptest.NewServer(issuer)
+	t.Cleanup(issuer.Close)
+
+	return issuer
+}
+
+func (f *fakeIssuer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.URL.Path {
+	case "/.well-known/openid-configuration":
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                f.URL,
+			"authorization_endpoint":                f.URL + "/authorize",
+			"token_endpoint":                        f.URL + "/token",
+			"jwks_uri":                              f.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	case "/keys":
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]any{{
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"kid": testKeyID,
+				"n":   base64.RawURLEncoding.EncodeToString(f.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(f.key.E)).Bytes()),
+			}},
+		})
+	case "/token":
+		f.mu.Lock()
+		claims := map[string]any{"nonce": f.nonce}
+		for name, value := range f.claims {
+			claims[name] = value
+		}
+		f.mu.Unlock()
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     f.sign(claims),
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// idToken returns the claims of a valid ID token for the user, which the
+// tests alter to make it invalid.
+func (f *fakeIssuer) idToken(email string) map[string]any {
+	now := time.Now()
+
+	return map[string]any{
+		"iss":    f.URL,
+		"sub":    "user-1",
+		"aud":    testClientID,
+		"iat":    now.Unix(),
+		"exp":    now.Add(time.Hour).Unix(),
+		"email":  email,
+		"groups": []string{"ops"},
+	}
+}
+
+// sign encodes and signs the claims as an RS256 JSON web token.
+func (f *fakeIssuer) sign(claims map[string]any) string {
+	header, _ := json.Marshal(map[string]any{"alg": "RS256", "kid": testKeyID, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signingInput))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, f.key, crypto.SHA256, digest[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// newOIDCHandler returns a handler authenticated with the identity provider,
+// which responds with the name of the authenticated user.
+func newOIDCHandler(t *testing.T, issuer *fakeIssuer) http.Handler {
+	t.Helper()
+
+	authenticator, err := middleware.NewOIDCAuthenticator(context.Background(), middleware.OIDCConfig{
+		IssuerURL:    issuer.URL,
+		ClientID:     testClientID,
+		ClientSecret: "secret",
+		RedirectURL:  "http://logs.example.com/auth/oidc/callback",
+	})
+	if err != nil {
+		t.Fatalf("NewOIDCAuthenticator: %v", err)
+	}
+
+	return middleware.Authentication(authenticator)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, _ := middleware.UserFromContext(r.Context())
+		w.Write([]byte(user.Name + " " + strings.Join(user.Groups, ",")))
+	}))
+}
+
+func TestOIDCBearerToken(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	handler := newOIDCHandler(t, issuer)
+
+	tests := []struct {
+		name   string
+		modify func(claims map[string]any)
+		status int
+	}{
+		{
+			name:   "valid",
+			modify: func(claims map[string]any) {},
+			status: http.StatusOK,
+		},
+		{
+			name: "expired",
+			modify: func(claims map[string]any) {
+				claims["iat"] = time.Now().Add(-2 * time.Hour).Unix()
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "wrong audience",
+			modify: func(claims map[string]any) {
+				claims["aud"] = "another-client"
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "wrong issuer",
+			modify: func(claims map[string]any) {
+				claims["iss"] = "https://issuer.example.com"
+			},
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			claims := issuer.idToken("alice@example.com")
+			test.modify(claims)
+
+			request := httptest.NewRequest(http.MethodGet, "/api/logs", nil)
+			request.Header.Set("Authorization", "Bearer "+issuer.sign(claims))
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != test.status {
+				t.Fatalf("status = %d, want %d", recorder.Code, test.status)
+			}
+
+			if test.status == http.StatusOK {
+				if body := recorder.Body.String(); body != "alice@example.com ops" {
+					t.Errorf("authenticated as %q, want alice@example.com in ops", body)
+				}
+
+				return
+			}
+
+			if challenge := recorder.Header().Get("WWW-Authenticate"); challenge != "Bearer" {
+				t.Errorf("WWW-Authenticate = %q, want Bearer", challenge)
+			}
+		})
+	}
+}
+
+func TestOIDCSession(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	handler := newOIDCHandler(t, issuer)
+
+	serve := func(target string, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, target, nil)
+
+		for _, cookie := range cookies {
+			request.AddCookie(cookie)
+		}
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	// Browsers without a session are sent to log in.
+	response := serve("/log?path=prod%2Fapp.log")
+	if response.Code != http.StatusFound {
+		t.Fatalf("status without a session = %d, want a redirect to log in", response.Code)
+	}
+
+	response = serve(response.Header().Get("Location"))
+	if response.Code != http.StatusFound {
+		t.Fatalf("login status = %d, want a redirect to the identity provider", response.Code)
+	}
+
+	authorizeURL, err := url.Parse(response.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(authorizeURL.String(), issuer.URL+"/authorize") {
+		t.Fatalf("login redirected to %s, want the identity provider", authorizeURL)
+	}
+
+	stateCookies := response.Result().Cookies()
+
+	issuer.mu.Lock()
+	issuer.nonce = authorizeURL.Query().Get("nonce")
+	issuer.claims = issuer.idToken("bob@example.com")
+	issuer.mu.Unlock()
+
+	callback := url.URL{
+		Path: middleware.OIDCLoginPath + "callback",
+		RawQuery: url.Values{
+			"code":  {"code"},
+			"state": {authorizeURL.Query().Get("state")},
+		}.Encode(),
+	}
+
+	response = serve(callback.String(), stateCookies...)
+	if response.Code != http.StatusFound {
+		t.Fatalf("callback status = %d: %s", response.Code, response.Body)
+	}
+
+	if location := response.Header().Get("Location"); location != "/log?path=prod%2Fapp.log" {
+		t.Errorf("callback redirected to %q, want the requested page", location)
+	}
+
+	var sessionCookie *http.Cookie
+
+	for _, cookie := range response.Result().Cookies() {
+		if cookie.Value != "" {
+			sessionCookie = cookie
+		}
+	}
+
+	if sessionCookie == nil {
+		t.Fatal("the callback did not start a session")
+	}
+
+	// The session cookie authenticates later requests.
+	response = serve("/api/logs", sessionCookie)
+	if response.Code != http.StatusOK || response.Body.String() != "bob@example.com ops" {
+		t.Errorf("request with the session returned %d %q, want bob@example.com", response.Code, response.Body)
+	}
+
+	// A tampered session cookie does not.
+	tampered := *sessionCookie
+	tampered.Value = "x" + tampered.Value[1:]
+
+	response = serve("/api/logs", &tampered)
+	if response.Code != http.StatusUnauthorized {
+		t.Errorf("request with a tampered session returned %d, want 401", response.Code)
+	}
+}
@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// ErrInvalidTokenFile is returned when a token file cannot be parsed.
+var ErrInvalidTokenFile = errors.New("middleware: invalid token file")
+
+// TokenAuthenticator authenticates requests bearing one of a set of static
+// tokens.
+type TokenAuthenticator struct {
+	// users maps the SHA-256 hash of each token to its user, so that looking
+	// up a token does not leak its contents through timing.
+	users map[[sha256.Size]byte]User
+}
+
+// Ensure that TokenAuthenticator implements the Authenticator and Challenger
+// interfaces.
+var (
+	_ Authenticator = &TokenAuthenticator{}
+	_ Challenger    = &TokenAuthenticator{}
+)
+
+// NewTokenAuthenticator creates a new authenticator accepting the given
+// tokens, mapped to the users they authenticate.
+func NewTokenAuthenticator(tokens map[string]User) *TokenAuthenticator {
+	users := make(map[[sha256.Size]byte]User, len(tokens))
+
+	for token, user := range tokens {
+		users[sha256.Sum256([]byte(token))] = user
+	}
+
+	return &TokenAuthenticator{
+		users: users,
+	}
+}
+
+// LoadTokenFile loads a static token file, in the same CSV format as the
+// Kubernetes API server: "token,user,uid,\"group1,group2\"", where the uid and
+// groups are optional.
+func LoadTokenFile(filename string) (*TokenAuthenticator, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("middleware: opening token file: %w", err)
+	}
+
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+
+	tokens := make(map[string]User)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidTokenFile, err)
+		}
+
+		if len(record) < 2 || record[0] == "" || record[1] == "" {
+			return nil, fmt.Errorf("%w: each line requires a token and user", ErrInvalidTokenFile)
+		}
+
+		user := User{
+			Name: record[1],
+		}
+
+		if len(record) >= 4 && record[3] != "" {
+			user.Groups = strings.Split(record[3], ",")
+		}
+
+		tokens[record[0]] = user
+	}
+
+	return NewTokenAuthenticator(tokens), nil
+}
+
+// Authenticate returns the user of the request's bearer token.
+func (t *TokenAuthenticator) Authenticate(r *http.Request) (User, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return User{}, ErrNoCredentials
+	}
+
+	user, ok := t.users[sha256.Sum256([]byte(token))]
+	if !ok {
+		return User{}, fmt.Errorf("%w: unknown bearer token", ErrInvalidCredentials)
+	}
+
+	return user, nil
+}
+
+// Challenge advertises bearer token authentication.
+func (t *TokenAuthenticator) Challenge(w http.ResponseWriter, r *http.Request) bool {
+	w.Header().Add("WWW-Authenticate", "Bearer")
+
+	return false
+}
+
+// bearerToken returns the bearer token from the request's Authorization
+// header.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrNoCredentials is returned by an Authenticator when the request does
+	// not carry any credentials that it understands.
+	ErrNoCredentials = errors.New("middleware: no credentials")
+
+	// ErrInvalidCredentials is returned by an Authenticator when the request
+	// carries credentials that it understands, but which are not valid.
+	ErrInvalidCredentials = errors.New("middleware: invalid credentials")
+)
+
+// userContextKey is the context key under which the authenticated user is
+// stored.
+type userContextKey struct{}
+
+// User is an authenticated user of the viewer.
+type User struct {
+	Name   string   `json:"name"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// ContextWithUser returns a copy of the context carrying the given user.
+func ContextWithUser(ctx context.Context, user User) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFromContext returns the user authenticated by the [Authentication]
+// middleware, if any.
+func UserFromContext(ctx context.Context) (User, bool) {
+	user, ok := ctx.Value(userContextKey{}).(User)
+
+	return user, ok
+}
+
+// Authenticator authenticates the user making a request.
+type Authenticator interface {
+	// Authenticate returns the user making the request, or an error wrapping
+	// [ErrNoCredentials] or [ErrInvalidCredentials].
+	Authenticate(r *http.Request) (User, error)
+}
+
+// Challenger is implemented by authenticators which can prompt an
+// unauthenticated client for credentials.
+type Challenger interface {
+	// Challenge prompts the client for credentials, e.g. by setting the
+	// WWW-Authenticate header. It returns true if it has written the
+	// response, e.g. by redirecting to a login page.
+	Challenge(w http.ResponseWriter, r *http.Request) bool
+}
+
+// LoginHandler is implemented by authenticators which serve their own
+// endpoints, such as for logging in, which must be reachable without
+// authentication.
+type LoginHandler interface {
+	http.Handler
+
+	// LoginPath returns the path prefix of the endpoints served by the
+	// authenticator.
+	LoginPath() string
+}
+
+// Authentication requires that every request is authenticated by one of the
+// given authenticators, storing the user in the request context.
+//
+// Unauthenticated requests are challenged by each authenticator in turn, and
+// are otherwise rejected as unauthorized.
+func Authentication(authenticators ...Authenticator) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, authenticator := range authenticators {
+				loginHandler, ok := authenticator.(LoginHandler)
+				if ok && strings.HasPrefix(r.URL.Path, loginHandler.LoginPath()) {
+					loginHandler.ServeHTTP(w, r)
+
+					return
+				}
+			}
+
+			for _, authenticator := range authenticators {
+				user, err := authenticator.Authenticate(r)
+				if err == nil {
+					next.ServeHTTP(w, r.WithContext(ContextWithUser(r.Context(), user)))
+
+					return
+				}
+
+				if !errors.Is(err, ErrNoCredentials) {
+					slog.InfoContext(
+						r.Context(),
+						"authentication failed",
+						slog.Any("error", err),
+					)
+				}
+			}
+
+			for _, authenticator := range authenticators {
+				challenger, ok := authenticator.(Challenger)
+				if ok && challenger.Challenge(w, r) {
+					return
+				}
+			}
+
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		})
+	}
+}
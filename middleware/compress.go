@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+	"path"
+	"slices"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// compressionLevel is the level that responses are compressed at, trading
+// compression for the speed of streaming large responses.
+const compressionLevel = 5
+
+// Compress compresses the responses to requests to the given paths, such as
+// exports of whole logs, with gzip or deflate if the client accepts it.
+//
+// The middleware must precede any middleware measuring the responses, such as
+// [Audit], for it to measure the uncompressed content.
+func Compress(paths ...string) func(next http.Handler) http.Handler {
+	compress := chimiddleware.Compress(
+		compressionLevel,
+		"text/*",
+		"application/json",
+		"application/x-ndjson",
+	)
+
+	return func(next http.Handler) http.Handler {
+		compressed := compress(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !slices.Contains(paths, path.Clean(r.URL.Path)) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			compressed.ServeHTTP(w, r)
+		})
+	}
+}
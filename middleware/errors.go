@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+)
+
+// ErrorsDir is the directory, within the root template directory, holding the
+// templates that errors are rendered with, rather than pages. A template named
+// after a status code, e.g. "errors/404.tmpl.html", renders errors with that
+// status, and "errors/error.tmpl.html" renders any other error.
+const ErrorsDir = "errors"
+
+// defaultErrorTemplate is the name of the error template rendering errors
+// without a template of their own.
+const defaultErrorTemplate = "error"
+
+// lookupError returns the templates that an error with the given status is
+// rendered with, one for each format that it can be rendered in. The default
+// error templates are used for formats without a template for the status.
+func (t *Templates) lookupError(status int) []Template {
+	templates := slices.Clone(t.errorTemplates[strconv.Itoa(status)])
+
+	for _, template := range t.errorTemplates[defaultErrorTemplate] {
+		if !slices.ContainsFunc(templates, func(candidate Template) bool {
+			return candidate.Format == template.Format
+		}) {
+			templates = append(templates, template)
+		}
+	}
+
+	return templates
+}
+
+// decodeAPIError decodes the error body of a failed API response, such as
+// {"code": "not_found", "message": "..."}. Nil is returned if the body is not
+// a JSON object.
+func decodeAPIError(apiResponse *responseRecorder) map[string]any {
+	var apiError map[string]any
+
+	if err := json.Unmarshal(apiResponse.body.Bytes(), &apiError); err != nil {
+		return nil
+	}
+
+	return apiError
+}
+
+// RenderError responds with an error page for the given status and message,
+// for handlers outside of the render middleware, such as redirects.
+func RenderError(
+	w http.ResponseWriter,
+	r *http.Request,
+	templates TemplateProvider,
+	status int,
+	message string,
+) {
+	renderError(w, r, templates.Templates(), status, map[string]any{
+		"message": message,
+	})
+}
+
+// renderError responds with an error page for the given status, rendered in
+// the format negotiated with the request.
+//
+// The error template is given the status and its text, and the code and
+// message of the API error, if any, which would otherwise default to the
+// status text. A plain error is served if no error template can be rendered.
+func renderError(
+	w http.ResponseWriter,
+	r *http.Request,
+	templates *Templates,
+	status int,
+	apiError map[string]any,
+) {
+	data := map[string]any{
+		"status":      status,
+		"status_text": http.StatusText(status),
+		"code":        "",
+		"message":     http.StatusText(status),
+		"Request":     r.URL,
+	}
+
+	if code, ok := apiError["code"].(string); ok {
+		data["code"] = code
+	}
+
+	if message, ok := apiError["message"].(string); ok && message != "" {
+		data["message"] = message
+	}
+
+	candidates := templates.lookupError(status)
+
+	formats := make([]string, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		if candidate.Templated {
+			formats = append(formats, candidate.Format)
+		}
+	}
+
+	if len(formats) == 0 {
+		http.Error(w, data["message"].(string), status)
+
+		return
+	}
+
+	// The error is served in the first format if none is acceptable, as the
+	// error itself may be that no format is acceptable.
+	format, ok := negotiate(r, formats)
+	if !ok {
+		format = formats[0]
+	}
+
+	for _, candidate := range candidates {
+		if !candidate.Templated || candidate.Format != format {
+			continue
+		}
+
+		var rendered bytes.Buffer
+
+		if err := candidate.executor.Execute(&rendered, data); err != nil {
+			slog.ErrorContext(
+				r.Context(),
+				"failed to render error template",
+				slog.Any("error", err),
+			)
+
+			break
+		}
+
+		w.Header().Set("Content-Type", candidate.Format)
+		w.WriteHeader(status)
+		w.Write(rendered.Bytes())
+
+		return
+	}
+
+	http.Error(w, data["message"].(string), status)
+}
@@ -0,0 +1,226 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"testing/fstest"
+
+	"github.com/crystalix007/log-viewer/middleware"
+)
+
+// failingAPI responds to every request with the status and body given by its
+// query, e.g. "?status=404&body=...", or succeeds if not given a status.
+var failingAPI = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	status, err := strconv.Atoi(r.URL.Query().Get("status"))
+	if err != nil {
+		status = http.StatusOK
+	}
+
+	w.Header().Set("Content-Type", middleware.JSONFormat)
+	w.WriteHeader(status)
+	w.Write([]byte(r.URL.Query().Get("body")))
+})
+
+// newErrorTemplates returns the templates of a log page, optionally with error
+// templates for 404 errors in HTML, and any error in HTML and text.
+func newErrorTemplates(t *testing.T, withErrors bool) *middleware.Templates {
+	t.Helper()
+
+	files := fstest.MapFS{
+		"log/index.tmpl.html": {Data: []byte("<p>log</p>")},
+		"log/index.tmpl.txt":  {Data: []byte("log")},
+	}
+
+	if withErrors {
+		files["errors/404.tmpl.html"] = &fstest.MapFile{Data: []byte("<h1>Not found</h1><p>{{ .message }}</p>")}
+		files["errors/error.tmpl.html"] = &fstest.MapFile{Data: []byte("<h1>{{ .status }} {{ .status_text }}</h1><p>{{ .code }}: {{ .message }}</p>")}
+		files["errors/error.tmpl.txt"] = &fstest.MapFile{Data: []byte("{{ .status }} {{ .code }}: {{ .message }}")}
+	}
+
+	templates, err := middleware.NewTemplates(files, ".")
+	if err != nil {
+		t.Fatalf("NewTemplates: %v", err)
+	}
+
+	return templates
+}
+
+func TestErrorPages(t *testing.T) {
+	handler := middleware.RenderMiddleware(newErrorTemplates(t, true), failingAPI)(http.NotFoundHandler())
+
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		status          int
+		body            string
+		format          string
+		accept          string
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "status template",
+			path:            "/log",
+			status:          http.StatusNotFound,
+			body:            `{"code":"not_found","message":"The specified path does not exist"}`,
+			accept:          "text/html",
+			wantStatus:      http.StatusNotFound,
+			wantContentType: "text/html",
+			wantBody:        "<h1>Not found</h1><p>The specified path does not exist</p>",
+		},
+		{
+			name:            "default template of a format without a status template",
+			path:            "/log",
+			status:          http.StatusNotFound,
+			body:            `{"code":"not_found","message":"The specified path does not exist"}`,
+			accept:          "text/plain",
+			wantStatus:      http.StatusNotFound,
+			wantContentType: "text/plain",
+			wantBody:        "404 not_found: The specified path does not exist",
+		},
+		{
+			name:            "default template",
+			path:            "/log",
+			status:          http.StatusBadRequest,
+			body:            `{"code":"invalid_request","message":"Invalid <line>"}`,
+			accept:          "text/html",
+			wantStatus:      http.StatusBadRequest,
+			wantContentType: "text/html",
+			wantBody:        "<h1>400 Bad Request</h1><p>invalid_request: Invalid &lt;line&gt;</p>",
+		},
+		{
+			name:            "empty message",
+			path:            "/log",
+			status:          http.StatusForbidden,
+			body:            `{"code":"forbidden","message":""}`,
+			accept:          "text/plain",
+			wantStatus:      http.StatusForbidden,
+			wantContentType: "text/plain",
+			wantBody:        "403 forbidden: Forbidden",
+		},
+		{
+			name:            "body not an API error",
+			path:            "/log",
+			status:          http.StatusBadGateway,
+			body:            `upstream failed`,
+			accept:          "text/plain",
+			wantStatus:      http.StatusBadGateway,
+			wantContentType: "text/plain",
+			wantBody:        "502 : Bad Gateway",
+		},
+		{
+			name:            "unknown page",
+			path:            "/missing",
+			accept:          "text/html",
+			wantStatus:      http.StatusNotFound,
+			wantContentType: "text/html",
+			wantBody:        "<h1>Not found</h1><p>Not Found</p>",
+		},
+		{
+			name:            "method not allowed",
+			method:          http.MethodPost,
+			path:            "/log",
+			accept:          "text/plain",
+			wantStatus:      http.StatusMethodNotAllowed,
+			wantContentType: "text/plain",
+			wantBody:        "405 : Method Not Allowed",
+		},
+		{
+			name:            "format parameter",
+			path:            "/log",
+			status:          http.StatusInternalServerError,
+			format:          "txt",
+			accept:          "text/html",
+			wantStatus:      http.StatusInternalServerError,
+			wantContentType: "text/plain",
+			wantBody:        "500 : Internal Server Error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method := test.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			query := url.Values{}
+
+			if test.status != 0 {
+				query.Set("status", strconv.Itoa(test.status))
+				query.Set("body", test.body)
+			}
+
+			if test.format != "" {
+				query.Set(middleware.FormatParameter, test.format)
+			}
+
+			target := test.path + "?" + query.Encode()
+
+			r := httptest.NewRequest(method, target, nil)
+			r.Header.Set("Accept", test.accept)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != test.wantStatus || w.Body.String() != test.wantBody {
+				t.Errorf("%s %s = %d %q, want %d %q", method, target, w.Code, w.Body, test.wantStatus, test.wantBody)
+			}
+
+			if contentType := w.Header().Get("Content-Type"); contentType != test.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", contentType, test.wantContentType)
+			}
+		})
+	}
+}
+
+func TestErrorPagesWithoutTemplates(t *testing.T) {
+	handler := middleware.RenderMiddleware(newErrorTemplates(t, false), failingAPI)(http.NotFoundHandler())
+
+	query := url.Values{
+		"status": {"404"},
+		"body":   {`{"code":"not_found","message":"The specified path does not exist"}`},
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/log?"+query.Encode(), nil)
+	r.Header.Set("Accept", "text/html")
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if want := "The specified path does not exist\n"; w.Code != http.StatusNotFound || w.Body.String() != want {
+		t.Errorf("GET = %d %q, want %d %q", w.Code, w.Body, http.StatusNotFound, want)
+	}
+}
+
+func TestRenderError(t *testing.T) {
+	templates := newErrorTemplates(t, true)
+
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"text/html", "<h1>400 Bad Request</h1><p>: The view does not exist</p>"},
+		{"text/plain", "400 : The view does not exist"},
+		{"image/png", "<h1>400 Bad Request</h1><p>: The view does not exist</p>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.accept, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/v/abc", nil)
+			r.Header.Set("Accept", test.accept)
+
+			w := httptest.NewRecorder()
+			middleware.RenderError(w, r, templates, http.StatusBadRequest, "The view does not exist")
+
+			if w.Code != http.StatusBadRequest || w.Body.String() != test.want {
+				t.Errorf("RenderError = %d %q, want %d %q", w.Code, w.Body, http.StatusBadRequest, test.want)
+			}
+		})
+	}
+}
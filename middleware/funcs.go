@@ -0,0 +1,316 @@
+package middleware
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	htmltemplate "html/template"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crystalix007/log-viewer/ansi"
+	"github.com/crystalix007/log-viewer/structured"
+)
+
+// ErrOddQueryPairs is returned when a query is built from an odd number of
+// keys and values.
+var ErrOddQueryPairs = errors.New("middleware: query requires pairs of keys and values")
+
+// templateFuncs are the functions available to all templates.
+var templateFuncs = map[string]any{
+	"from_base64":  DecodeBase64,
+	"with_query":   WithQuery,
+	"query":        Query,
+	"hash_colour":  HashColour,
+	"level_colour": LevelColour,
+	"split_lines":  SplitLines,
+	"int":          ToInt,
+	"human_size":   HumanSize,
+	"format_time":  FormatTime,
+	"json_pretty":  JSONPretty,
+	"ansi_html":    ANSIToHTML,
+	"strip_ansi":   StripANSI,
+	"json_line":    JSONLine,
+	"levels":       Levels,
+	"contains":     Contains,
+}
+
+// DecodeBase64 decodes a base64-encoded string.
+//
+// The decoded string is untrusted, e.g. the contents of a log, so is escaped
+// when rendered into HTML.
+func DecodeBase64(str string) (string, error) {
+	bs, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return "", fmt.Errorf("middleware: decoding template base64: %w", err)
+	}
+
+	return string(bs), nil
+}
+
+// WithQuery returns the path and query of the given URL, with the query
+// parameters set to the given alternating keys and values. Parameters set to
+// nil or an empty string are removed.
+func WithQuery(u *url.URL, pairs ...any) (string, error) {
+	if len(pairs)%2 != 0 {
+		return "", ErrOddQueryPairs
+	}
+
+	query := u.Query()
+
+	for i := 0; i < len(pairs); i += 2 {
+		key := fmt.Sprint(pairs[i])
+
+		if pairs[i+1] == nil || pairs[i+1] == "" {
+			query.Del(key)
+
+			continue
+		}
+
+		query.Set(key, fmt.Sprint(pairs[i+1]))
+	}
+
+	withQuery := *u
+	withQuery.RawQuery = query.Encode()
+
+	return withQuery.RequestURI(), nil
+}
+
+// HashColour returns a CSS colour derived from the hash of the given string,
+// so that the same string is always shown in the same colour.
+//
+// The colour is marked as safe CSS, as it is generated rather than taken from
+// the string.
+func HashColour(str string) htmltemplate.CSS {
+	hash := fnv.New32a()
+	hash.Write([]byte(str))
+
+	return htmltemplate.CSS(fmt.Sprintf("hsl(%d, 65%%, 40%%)", hash.Sum32()%360))
+}
+
+// SplitLines splits a string into its lines.
+func SplitLines(str string) []string {
+	return strings.Split(str, "\n")
+}
+
+// ToInt converts a number decoded from JSON into an integer, allowing it to be
+// compared against template loop indices.
+func ToInt(value any) (int, error) {
+	switch value := value.(type) {
+	case int:
+		return value, nil
+	case float64:
+		return int(value), nil
+	default:
+		return 0, fmt.Errorf("middleware: cannot convert %T to int", value)
+	}
+}
+
+// Query builds a URL query from alternating keys and values, escaping each of
+// them, e.g. "path=prod%2Fapp.log&page=2". Nil values are omitted.
+//
+// The query is marked as a safe URL, so that it is not escaped a second time
+// when rendered into a link.
+func Query(pairs ...any) (htmltemplate.URL, error) {
+	if len(pairs)%2 != 0 {
+		return "", ErrOddQueryPairs
+	}
+
+	query := make(url.Values, len(pairs)/2)
+
+	for i := 0; i < len(pairs); i += 2 {
+		if pairs[i+1] == nil {
+			continue
+		}
+
+		query.Add(fmt.Sprint(pairs[i]), fmt.Sprint(pairs[i+1]))
+	}
+
+	return htmltemplate.URL(query.Encode()), nil
+}
+
+// levelColours are the colours of log levels, by their lowercase names.
+var levelColours = map[string]htmltemplate.CSS{
+	"trace":   "#adb5bd",
+	"debug":   "#6c757d",
+	"info":    "#0d6efd",
+	"notice":  "#0d6efd",
+	"warn":    "#d39e00",
+	"warning": "#d39e00",
+	"error":   "#dc3545",
+	"err":     "#dc3545",
+	"fatal":   "#842029",
+	"panic":   "#842029",
+	"crit":    "#842029",
+}
+
+// LevelColour returns the CSS colour of a log level, such as "info" or
+// "ERROR", or "inherit" for unknown levels.
+func LevelColour(level any) htmltemplate.CSS {
+	colour, ok := levelColours[strings.ToLower(fmt.Sprint(level))]
+	if !ok {
+		return "inherit"
+	}
+
+	return colour
+}
+
+// sizeUnits are the binary units that sizes are formatted in.
+var sizeUnits = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+
+// HumanSize formats a number of bytes in binary units, e.g. "1.5 MiB".
+func HumanSize(value any) (string, error) {
+	size, err := ToInt(value)
+	if err != nil {
+		return "", err
+	}
+
+	scaled := float64(size)
+	unit := 0
+
+	for scaled >= 1024 && unit < len(sizeUnits)-1 {
+		scaled /= 1024
+		unit++
+	}
+
+	if unit == 0 {
+		return fmt.Sprintf("%d %s", size, sizeUnits[unit]), nil
+	}
+
+	return fmt.Sprintf("%.1f %s", scaled, sizeUnits[unit]), nil
+}
+
+// timeLayouts are the layouts that can be given to [FormatTime] by name.
+var timeLayouts = map[string]string{
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"RFC1123":     time.RFC1123,
+	"Kitchen":     time.Kitchen,
+	"DateTime":    time.DateTime,
+	"DateOnly":    time.DateOnly,
+	"TimeOnly":    time.TimeOnly,
+	"StampMilli":  time.StampMilli,
+}
+
+// FormatTime formats a time with a layout, which is either a layout of the
+// time package or the name of one, e.g. "DateTime". Times decoded from JSON
+// are parsed as RFC 3339, and a nil time is formatted as an empty string.
+func FormatTime(layout string, value any) (string, error) {
+	if named, ok := timeLayouts[layout]; ok {
+		layout = named
+	}
+
+	switch value := value.(type) {
+	case nil:
+		return "", nil
+	case time.Time:
+		return value.Format(layout), nil
+	case *time.Time:
+		if value == nil {
+			return "", nil
+		}
+
+		return value.Format(layout), nil
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return "", fmt.Errorf("middleware: parsing time: %w", err)
+		}
+
+		return parsed.Format(layout), nil
+	default:
+		return "", fmt.Errorf("middleware: cannot format %T as a time", value)
+	}
+}
+
+// JSONPretty formats a value as indented JSON.
+func JSONPretty(value any) (string, error) {
+	bs, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("middleware: formatting JSON: %w", err)
+	}
+
+	return string(bs), nil
+}
+
+// ANSIHTML is text coloured with ANSI escape sequences, converted to HTML.
+type ANSIHTML struct {
+	// Lines are the lines of the text, each closing any element it opens.
+	Lines []htmltemplate.HTML
+
+	// State is the style in effect at the end of the text, which the text
+	// following it, e.g. the next page of a log, is converted from.
+	State string
+}
+
+// HTML returns the lines of the text joined by newlines.
+func (a ANSIHTML) HTML() htmltemplate.HTML {
+	var joined strings.Builder
+
+	for i, line := range a.Lines {
+		if i > 0 {
+			joined.WriteByte('\n')
+		}
+
+		joined.WriteString(string(line))
+	}
+
+	return htmltemplate.HTML(joined.String())
+}
+
+// ANSIToHTML converts text coloured with ANSI escape sequences to HTML,
+// starting from the state at the end of the preceding text, if any. An invalid
+// state is ignored, starting from unstyled text.
+//
+// The HTML is marked as safe, as the text itself is escaped by the conversion.
+func ANSIToHTML(text string, state string) ANSIHTML {
+	style, err := ansi.ParseStyle(state)
+	if err != nil {
+		style = ansi.Style{}
+	}
+
+	lines, style := ansi.ToHTML(text, style)
+
+	converted := ANSIHTML{
+		Lines: make([]htmltemplate.HTML, len(lines)),
+		State: style.String(),
+	}
+
+	for i, line := range lines {
+		converted.Lines[i] = htmltemplate.HTML(line)
+	}
+
+	return converted
+}
+
+// StripANSI removes ANSI escape sequences from text.
+func StripANSI(text string) string {
+	return string(ansi.Strip([]byte(text)))
+}
+
+// JSONLine parses a log line as a structured (JSON) record, returning nil if
+// it is not one.
+func JSONLine(line string) *structured.Record {
+	record, ok := structured.Parse([]byte(line))
+	if !ok {
+		return nil
+	}
+
+	return &record
+}
+
+// Levels returns the normalised levels that log lines can be filtered by, from
+// the least to the most severe.
+func Levels() []string {
+	return structured.Levels
+}
+
+// Contains reports whether the values contain the value, e.g. whether a query
+// parameter has been given a value.
+func Contains(values []string, value string) bool {
+	return slices.Contains(values, value)
+}
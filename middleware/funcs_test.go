@@ -0,0 +1,263 @@
+package middleware_test
+
+import (
+	"errors"
+	htmltemplate "html/template"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/crystalix007/log-viewer/middleware"
+)
+
+func TestWithQuery(t *testing.T) {
+	u, err := url.Parse("/log?path=prod%2Fapp.log&page=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		pairs []any
+		want  string
+	}{
+		{"unchanged", nil, "/log?page=2&path=prod%2Fapp.log"},
+		{"set", []any{"page", 3}, "/log?page=3&path=prod%2Fapp.log"},
+		{"added and escaped", []any{"search", "a b&c"}, "/log?page=2&path=prod%2Fapp.log&search=a+b%26c"},
+		{"removed by an empty string", []any{"page", ""}, "/log?path=prod%2Fapp.log"},
+		{"removed by nil", []any{"page", nil, "path", nil}, "/log"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := middleware.WithQuery(u, test.pairs...)
+			if err != nil {
+				t.Fatalf("WithQuery: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("WithQuery(%v) = %q, want %q", test.pairs, got, test.want)
+			}
+		})
+	}
+
+	if _, err := middleware.WithQuery(u, "page"); !errors.Is(err, middleware.ErrOddQueryPairs) {
+		t.Errorf("WithQuery of an odd number of arguments returned %v, want %v", err, middleware.ErrOddQueryPairs)
+	}
+
+	if u.RawQuery != "path=prod%2Fapp.log&page=2" {
+		t.Errorf("WithQuery modified the URL to %q", u)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		pairs []any
+		want  htmltemplate.URL
+	}{
+		{"empty", nil, ""},
+		{"escaped", []any{"path", "prod/app log.txt"}, "path=prod%2Fapp+log.txt"},
+		{"sorted", []any{"path", "app.log", "line", 120}, "line=120&path=app.log"},
+		{"repeated", []any{"level", "info", "level", "error"}, "level=info&level=error"},
+		{"nil omitted", []any{"path", "app.log", "pin", nil}, "path=app.log"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := middleware.Query(test.pairs...)
+			if err != nil {
+				t.Fatalf("Query: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Query(%v) = %q, want %q", test.pairs, got, test.want)
+			}
+		})
+	}
+
+	if _, err := middleware.Query("path"); !errors.Is(err, middleware.ErrOddQueryPairs) {
+		t.Errorf("Query of an odd number of arguments returned %v, want %v", err, middleware.ErrOddQueryPairs)
+	}
+}
+
+func TestHumanSize(t *testing.T) {
+	tests := []struct {
+		size any
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{float64(1536), "1.5 KiB"},
+		{5 << 30, "5.0 GiB"},
+		{3 << 60, "3072.0 PiB"},
+	}
+
+	for _, test := range tests {
+		got, err := middleware.HumanSize(test.size)
+		if err != nil {
+			t.Errorf("HumanSize(%v): %v", test.size, err)
+
+			continue
+		}
+
+		if got != test.want {
+			t.Errorf("HumanSize(%v) = %q, want %q", test.size, got, test.want)
+		}
+	}
+
+	if _, err := middleware.HumanSize("1024"); err == nil {
+		t.Error("HumanSize of a string succeeded, want an error")
+	}
+}
+
+func TestToInt(t *testing.T) {
+	for _, value := range []any{3, float64(3), 3.7} {
+		if got, err := middleware.ToInt(value); err != nil || got != 3 {
+			t.Errorf("ToInt(%v) = %d, %v, want 3", value, got, err)
+		}
+	}
+
+	for _, value := range []any{"3", nil, int64(3)} {
+		if _, err := middleware.ToInt(value); err == nil {
+			t.Errorf("ToInt(%#v) succeeded, want an error", value)
+		}
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	moment := time.Date(2024, 1, 2, 15, 4, 5, 600_000_000, time.UTC)
+
+	tests := []struct {
+		name   string
+		layout string
+		value  any
+		want   string
+	}{
+		{"named layout", "DateTime", moment, "2024-01-02 15:04:05"},
+		{"layout", "2006/01/02", moment, "2024/01/02"},
+		{"pointer", "TimeOnly", &moment, "15:04:05"},
+		{"nil pointer", "TimeOnly", (*time.Time)(nil), ""},
+		{"nil", "DateTime", nil, ""},
+		{"decoded from JSON", "RFC3339Nano", "2024-01-02T15:04:05.6Z", "2024-01-02T15:04:05.6Z"},
+		{"decoded from JSON without fractions", "Kitchen", "2024-01-02T15:04:05Z", "3:04PM"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := middleware.FormatTime(test.layout, test.value)
+			if err != nil {
+				t.Fatalf("FormatTime: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("FormatTime(%q, %v) = %q, want %q", test.layout, test.value, got, test.want)
+			}
+		})
+	}
+
+	for _, value := range []any{"yesterday", 1704207845} {
+		if _, err := middleware.FormatTime("DateTime", value); err == nil {
+			t.Errorf("FormatTime(%#v) succeeded, want an error", value)
+		}
+	}
+}
+
+func TestLevelColour(t *testing.T) {
+	tests := []struct {
+		level any
+		want  htmltemplate.CSS
+	}{
+		{"error", "#dc3545"},
+		{"ERROR", "#dc3545"},
+		{"Warning", "#d39e00"},
+		{"verbose", "inherit"},
+		{nil, "inherit"},
+	}
+
+	for _, test := range tests {
+		if got := middleware.LevelColour(test.level); got != test.want {
+			t.Errorf("LevelColour(%v) = %q, want %q", test.level, got, test.want)
+		}
+	}
+}
+
+func TestHashColour(t *testing.T) {
+	first := middleware.HashColour("prod/app.log")
+
+	if again := middleware.HashColour("prod/app.log"); again != first {
+		t.Errorf("HashColour changed from %q to %q", first, again)
+	}
+
+	if other := middleware.HashColour("prod/web.log"); other == first {
+		t.Errorf("HashColour of different strings were both %q", first)
+	}
+}
+
+func TestDecodeBase64(t *testing.T) {
+	if got, err := middleware.DecodeBase64("PGI+aGk8L2I+"); err != nil || got != "<b>hi</b>" {
+		t.Errorf("DecodeBase64 = %q, %v, want %q", got, err, "<b>hi</b>")
+	}
+
+	if _, err := middleware.DecodeBase64("not base64!"); err == nil {
+		t.Error("DecodeBase64 of invalid base64 succeeded, want an error")
+	}
+}
+
+func TestJSONPretty(t *testing.T) {
+	got, err := middleware.JSONPretty(map[string]any{"level": "info", "fields": []int{1, 2}})
+	if err != nil {
+		t.Fatalf("JSONPretty: %v", err)
+	}
+
+	want := "{\n  \"fields\": [\n    1,\n    2\n  ],\n  \"level\": \"info\"\n}"
+	if got != want {
+		t.Errorf("JSONPretty = %q, want %q", got, want)
+	}
+
+	if _, err := middleware.JSONPretty(func() {}); err == nil {
+		t.Error("JSONPretty of a function succeeded, want an error")
+	}
+}
+
+func TestANSIToHTML(t *testing.T) {
+	first := middleware.ANSIToHTML("<plain>\n\x1b[1mbold", "")
+
+	want := []htmltemplate.HTML{"&lt;plain&gt;", `<span style="font-weight: bold">bold</span>`}
+	if !slices.Equal(first.Lines, want) || first.State != "1" {
+		t.Errorf("ANSIToHTML = %q, state %q, want %q, state %q", first.Lines, first.State, want, "1")
+	}
+
+	if joined := first.HTML(); joined != want[0]+"\n"+want[1] {
+		t.Errorf("HTML() = %q, want the lines joined", joined)
+	}
+
+	// The next page continues in the style that the previous page ended in.
+	next := middleware.ANSIToHTML("still bold", first.State)
+	if want := `<span style="font-weight: bold">still bold</span>`; len(next.Lines) != 1 || string(next.Lines[0]) != want {
+		t.Errorf("ANSIToHTML continuing = %q, want %q", next.Lines, want)
+	}
+
+	invalid := middleware.ANSIToHTML("plain", "\x1b[1m")
+	if len(invalid.Lines) != 1 || invalid.Lines[0] != "plain" || invalid.State != "" {
+		t.Errorf("ANSIToHTML from an invalid state = %q, state %q, want it ignored", invalid.Lines, invalid.State)
+	}
+}
+
+func TestStripANSI(t *testing.T) {
+	if got := middleware.StripANSI("\x1b[31merror\x1b[0m: failed"); got != "error: failed" {
+		t.Errorf("StripANSI = %q, want %q", got, "error: failed")
+	}
+}
+
+func TestContains(t *testing.T) {
+	if !middleware.Contains([]string{"info", "error"}, "error") {
+		t.Error("Contains did not find a value")
+	}
+
+	if middleware.Contains(nil, "error") {
+		t.Error("Contains found a value in nil")
+	}
+}
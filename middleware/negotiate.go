@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"cmp"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// JSONFormat is the format of API responses, which can be requested instead of
+// a rendered template.
+const JSONFormat = "application/json"
+
+// FormatParameter is the query parameter which overrides the Accept header,
+// e.g. "?format=txt".
+const FormatParameter = "format"
+
+// formatNames are the names that formats can be requested by with the format
+// query parameter.
+var formatNames = map[string]string{
+	"html": extensionMimeType["html"],
+	"txt":  extensionMimeType["txt"],
+	"text": extensionMimeType["txt"],
+	"json": JSONFormat,
+}
+
+// mediaRange is a media range from an Accept header, such as "text/*;q=0.8".
+type mediaRange struct {
+	mediaType string
+	quality   float64
+}
+
+// specificity orders media ranges from the least specific, "*/*", to the most
+// specific, e.g. "text/html".
+func (m mediaRange) specificity() int {
+	switch {
+	case m.mediaType == "*/*":
+		return 0
+	case strings.HasSuffix(m.mediaType, "/*"):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// matches reports whether the format is within the media range.
+func (m mediaRange) matches(format string) bool {
+	if m.mediaType == "*/*" || m.mediaType == format {
+		return true
+	}
+
+	prefix, ok := strings.CutSuffix(m.mediaType, "*")
+
+	return ok && strings.HasPrefix(format, prefix)
+}
+
+// parseAccept parses an Accept header into its media ranges, from the most to
+// the least preferred. Ranges which are not acceptable, with a quality of
+// zero, are kept last, as they exclude the formats within them from less
+// specific ranges, e.g. "text/html;q=0, text/*".
+func parseAccept(header string) []mediaRange {
+	var ranges []mediaRange
+
+	for _, part := range strings.Split(header, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+
+		if q, ok := params["q"]; ok {
+			quality, err = strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+		}
+
+		ranges = append(ranges, mediaRange{
+			mediaType: mediaType,
+			quality:   quality,
+		})
+	}
+
+	slices.SortStableFunc(ranges, func(a, b mediaRange) int {
+		return cmp.Or(
+			cmp.Compare(b.quality, a.quality),
+			cmp.Compare(b.specificity(), a.specificity()),
+		)
+	})
+
+	return ranges
+}
+
+// negotiate chooses which of the formats to respond to the request with.
+//
+// The format query parameter takes precedence over the Accept header, and the
+// first format is chosen if neither expresses a preference. If none of the
+// formats is acceptable, false is returned.
+func negotiate(r *http.Request, formats []string) (string, bool) {
+	if name := r.URL.Query().Get(FormatParameter); name != "" {
+		// Unknown names are ignored, as the parameter may be meant for the
+		// API rather than for choosing a format.
+		if format, ok := formatNames[name]; ok {
+			return format, slices.Contains(formats, format)
+		}
+	}
+
+	accept := r.Header.Get("Accept")
+	if accept == "" {
+		return formats[0], true
+	}
+
+	ranges := parseAccept(accept)
+
+	var (
+		chosen     string
+		chosenRank = len(ranges)
+	)
+
+	for _, format := range formats {
+		if rank, ok := preference(ranges, format); ok && rank < chosenRank {
+			chosen, chosenRank = format, rank
+		}
+	}
+
+	return chosen, chosenRank < len(ranges)
+}
+
+// preference returns the index of the media range which a format is accepted
+// by, of the ranges from the most to the least preferred: the most preferred
+// of the most specific ranges that it is within. False is returned if the
+// format is not acceptable.
+func preference(ranges []mediaRange, format string) (int, bool) {
+	best := -1
+
+	for i, mediaRange := range ranges {
+		if !mediaRange.matches(format) {
+			continue
+		}
+
+		if best < 0 || mediaRange.specificity() > ranges[best].specificity() {
+			best = i
+		}
+	}
+
+	return best, best >= 0 && ranges[best].quality > 0
+}
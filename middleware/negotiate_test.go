@@ -0,0 +1,268 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestParseAccept(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   []mediaRange
+	}{
+		{
+			name:   "single",
+			header: "text/html",
+			want:   []mediaRange{{"text/html", 1}},
+		},
+		{
+			name:   "ordered by quality",
+			header: "text/plain;q=0.5, application/json;q=0.9, text/html",
+			want: []mediaRange{
+				{"text/html", 1},
+				{"application/json", 0.9},
+				{"text/plain", 0.5},
+			},
+		},
+		{
+			name:   "equal qualities ordered by specificity",
+			header: "*/*, text/*, text/html",
+			want: []mediaRange{
+				{"text/html", 1},
+				{"text/*", 1},
+				{"*/*", 1},
+			},
+		},
+		{
+			name:   "equal ranges keep their order",
+			header: "text/plain, text/html",
+			want: []mediaRange{
+				{"text/plain", 1},
+				{"text/html", 1},
+			},
+		},
+		{
+			name:   "unacceptable ranges last",
+			header: "text/html;q=0, text/plain",
+			want:   []mediaRange{{"text/plain", 1}, {"text/html", 0}},
+		},
+		{
+			name:   "malformed ranges omitted",
+			header: "text/html;q=high, /, text/plain;q=0.1",
+			want:   []mediaRange{{"text/plain", 0.1}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseAccept(test.header); !slices.Equal(got, test.want) {
+				t.Errorf("parseAccept(%q) = %v, want %v", test.header, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	formats := []string{"text/html", "text/plain", JSONFormat}
+
+	tests := []struct {
+		name    string
+		query   string
+		accept  string
+		formats []string
+		want    string
+		wantOK  bool
+	}{
+		{
+			name:   "no preference",
+			want:   "text/html",
+			wantOK: true,
+		},
+		{
+			name:   "exact",
+			accept: "text/plain",
+			want:   "text/plain",
+			wantOK: true,
+		},
+		{
+			name:   "highest quality",
+			accept: "text/html;q=0.5, application/json;q=0.8, text/plain;q=0.1",
+			want:   JSONFormat,
+			wantOK: true,
+		},
+		{
+			name:   "any type",
+			accept: "*/*",
+			want:   "text/html",
+			wantOK: true,
+		},
+		{
+			name:   "any subtype",
+			accept: "application/*",
+			want:   JSONFormat,
+			wantOK: true,
+		},
+		{
+			name:   "specific range preferred over wildcard",
+			accept: "text/*, text/plain",
+			want:   "text/plain",
+			wantOK: true,
+		},
+		{
+			name:   "wildcard with lower quality",
+			accept: "image/png, */*;q=0.1",
+			want:   "text/html",
+			wantOK: true,
+		},
+		{
+			name:   "unacceptable format excluded",
+			accept: "text/html;q=0, text/*",
+			want:   "text/plain",
+			wantOK: true,
+		},
+		{
+			name:   "quality of the most specific range",
+			accept: "text/*;q=0.5, text/html;q=0.1, application/json;q=0.3",
+			want:   "text/plain",
+			wantOK: true,
+		},
+		{
+			name:   "excluded from any type",
+			accept: "*/*, text/*;q=0",
+			want:   JSONFormat,
+			wantOK: true,
+		},
+		{
+			name:   "format parameter overrides accept",
+			query:  "format=txt",
+			accept: "text/html",
+			want:   "text/plain",
+			wantOK: true,
+		},
+		{
+			name:   "format parameter alias",
+			query:  "format=json",
+			want:   JSONFormat,
+			wantOK: true,
+		},
+		{
+			name:   "unknown format parameter ignored",
+			query:  "format=csv",
+			accept: "text/plain",
+			want:   "text/plain",
+			wantOK: true,
+		},
+		{
+			name:    "format parameter not available",
+			query:   "format=html",
+			formats: []string{"text/plain"},
+			want:    "text/html",
+		},
+		{
+			name:   "nothing acceptable",
+			accept: "image/png",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/logs?"+test.query, nil)
+			if test.accept != "" {
+				r.Header.Set("Accept", test.accept)
+			}
+
+			available := formats
+			if test.formats != nil {
+				available = test.formats
+			}
+
+			got, ok := negotiate(r, available)
+			if ok != test.wantOK || (ok && got != test.want) {
+				t.Errorf("negotiate(%q, Accept %q) = %q, %t, want %q, %t", test.query, test.accept, got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestRenderNotAcceptable(t *testing.T) {
+	templates, err := NewTemplates(fstest.MapFS{
+		"logs/index.tmpl.html":   {Data: []byte("<p>{{ .name }}</p>")},
+		"errors/error.tmpl.txt":  {Data: []byte("{{ .status }} {{ .message }}")},
+		"errors/error.tmpl.html": {Data: []byte("<p>{{ .status }} {{ .message }}</p>")},
+	}, ".")
+	if err != nil {
+		t.Fatalf("NewTemplates: %v", err)
+	}
+
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", JSONFormat)
+		w.Write([]byte(`{"name": "app.log"}`))
+	})
+
+	handler := RenderMiddleware(templates, api)(http.NotFoundHandler())
+
+	tests := []struct {
+		name       string
+		target     string
+		accept     string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "html",
+			target:     "/logs",
+			accept:     "text/html",
+			wantStatus: http.StatusOK,
+			wantBody:   "<p>app.log</p>",
+		},
+		{
+			name:       "json",
+			target:     "/logs",
+			accept:     "application/json",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"name": "app.log"}`,
+		},
+		{
+			name:       "no template in the accepted format",
+			target:     "/logs",
+			accept:     "text/plain",
+			wantStatus: http.StatusNotAcceptable,
+			wantBody:   "406 Not Acceptable",
+		},
+		{
+			// The error is served in the requested format, which has an
+			// error template.
+			name:       "no template in the requested format",
+			target:     "/logs?format=txt",
+			accept:     "text/html",
+			wantStatus: http.StatusNotAcceptable,
+			wantBody:   "406 Not Acceptable",
+		},
+		{
+			// The error is served in the first format, as none is acceptable.
+			name:       "nothing acceptable",
+			target:     "/logs",
+			accept:     "image/png",
+			wantStatus: http.StatusNotAcceptable,
+			wantBody:   "<p>406 Not Acceptable</p>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, test.target, nil)
+			r.Header.Set("Accept", test.accept)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != test.wantStatus || w.Body.String() != test.wantBody {
+				t.Errorf("GET %s (Accept %q) = %d %q, want %d %q", test.target, test.accept, w.Code, w.Body, test.wantStatus, test.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"errors"
+	"io/fs"
+	"slices"
+	"strings"
+)
+
+// OverlayFS layers one template source over another, so that each file is read
+// from the upper source if it exists there, and from the lower source
+// otherwise. Directories list the files of both sources.
+type OverlayFS struct {
+	upper TemplateSource
+	lower TemplateSource
+}
+
+// Ensure that OverlayFS implements TemplateSource.
+var _ TemplateSource = OverlayFS{}
+
+// NewOverlayFS creates a template source overlaying upper on lower.
+func NewOverlayFS(upper TemplateSource, lower TemplateSource) OverlayFS {
+	return OverlayFS{
+		upper: upper,
+		lower: lower,
+	}
+}
+
+// Open opens the named file from the upper source, falling back to the lower.
+func (o OverlayFS) Open(name string) (fs.File, error) {
+	file, err := o.upper.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return o.lower.Open(name)
+	}
+
+	return file, err
+}
+
+// ReadFile reads the named file from the upper source, falling back to the
+// lower.
+func (o OverlayFS) ReadFile(name string) ([]byte, error) {
+	contents, err := o.upper.ReadFile(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return o.lower.ReadFile(name)
+	}
+
+	return contents, err
+}
+
+// ReadDir lists the named directory in both sources, sorted by name. Entries
+// in the upper source replace those of the same name in the lower.
+func (o OverlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	upperEntries, upperErr := o.upper.ReadDir(name)
+	if upperErr != nil && !errors.Is(upperErr, fs.ErrNotExist) {
+		return nil, upperErr
+	}
+
+	lowerEntries, lowerErr := o.lower.ReadDir(name)
+	if lowerErr != nil && !errors.Is(lowerErr, fs.ErrNotExist) {
+		return nil, lowerErr
+	}
+
+	if upperErr != nil && lowerErr != nil {
+		return nil, upperErr
+	}
+
+	entries := slices.Clone(upperEntries)
+
+	for _, entry := range lowerEntries {
+		if !slices.ContainsFunc(upperEntries, func(upperEntry fs.DirEntry) bool {
+			return upperEntry.Name() == entry.Name()
+		}) {
+			entries = append(entries, entry)
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	return entries, nil
+}
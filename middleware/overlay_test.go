@@ -0,0 +1,180 @@
+package middleware_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	"github.com/crystalix007/log-viewer/middleware"
+)
+
+// newTestOverlay overlays a template directory on the templates that it
+// customises.
+func newTestOverlay() middleware.OverlayFS {
+	return middleware.NewOverlayFS(
+		fstest.MapFS{
+			"index.tmpl.html":      {Data: []byte("custom index")},
+			"errors/404.tmpl.html": {Data: []byte("custom not found")},
+			"partials/footer.html": {Data: []byte("custom footer")},
+			"upper/only.tmpl.txt":  {Data: []byte("upper only")},
+		},
+		fstest.MapFS{
+			"index.tmpl.html":        {Data: []byte("embedded index")},
+			"index.tmpl.txt":         {Data: []byte("embedded text index")},
+			"errors/404.tmpl.html":   {Data: []byte("embedded not found")},
+			"errors/error.tmpl.html": {Data: []byte("embedded error")},
+			"lower/only.tmpl.txt":    {Data: []byte("lower only")},
+		},
+	)
+}
+
+func TestOverlayFSReadFile(t *testing.T) {
+	overlay := newTestOverlay()
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"index.tmpl.html", "custom index"},
+		{"index.tmpl.txt", "embedded text index"},
+		{"errors/404.tmpl.html", "custom not found"},
+		{"errors/error.tmpl.html", "embedded error"},
+		{"upper/only.tmpl.txt", "upper only"},
+		{"lower/only.tmpl.txt", "lower only"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			contents, err := overlay.ReadFile(test.name)
+			if err != nil {
+				t.Fatalf("ReadFile: %v", err)
+			}
+
+			if string(contents) != test.want {
+				t.Errorf("ReadFile(%q) = %q, want %q", test.name, contents, test.want)
+			}
+
+			file, err := overlay.Open(test.name)
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+
+			defer file.Close()
+
+			contents, err = io.ReadAll(file)
+			if err != nil {
+				t.Fatalf("reading: %v", err)
+			}
+
+			if string(contents) != test.want {
+				t.Errorf("Open(%q) read %q, want %q", test.name, contents, test.want)
+			}
+		})
+	}
+
+	if _, err := overlay.ReadFile("missing.tmpl.html"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadFile of a missing file returned %v, want fs.ErrNotExist", err)
+	}
+
+	if _, err := overlay.Open("missing.tmpl.html"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Open of a missing file returned %v, want fs.ErrNotExist", err)
+	}
+}
+
+func TestOverlayFSReadDir(t *testing.T) {
+	overlay := newTestOverlay()
+
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{".", []string{"errors/", "index.tmpl.html", "index.tmpl.txt", "lower/", "partials/", "upper/"}},
+		{"errors", []string{"404.tmpl.html", "error.tmpl.html"}},
+		{"upper", []string{"only.tmpl.txt"}},
+		{"lower", []string{"only.tmpl.txt"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries, err := overlay.ReadDir(test.name)
+			if err != nil {
+				t.Fatalf("ReadDir: %v", err)
+			}
+
+			var names []string
+
+			for _, entry := range entries {
+				name := entry.Name()
+				if entry.IsDir() {
+					name += "/"
+				}
+
+				names = append(names, name)
+			}
+
+			if !slices.Equal(names, test.want) {
+				t.Errorf("ReadDir(%q) = %q, want %q", test.name, names, test.want)
+			}
+		})
+	}
+
+	if _, err := overlay.ReadDir("missing"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadDir of a missing directory returned %v, want fs.ErrNotExist", err)
+	}
+}
+
+func TestOverlayFSTemplates(t *testing.T) {
+	templates, err := middleware.NewTemplates(newTestOverlay(), ".")
+	if err != nil {
+		t.Fatalf("NewTemplates: %v", err)
+	}
+
+	// Each template is loaded once, with either source's templates.
+	candidates, err := templates.Lookup("/")
+	if err != nil {
+		t.Fatalf("Lookup: %v", err)
+	}
+
+	var formats []string
+	for _, candidate := range candidates {
+		formats = append(formats, candidate.Format)
+	}
+
+	slices.Sort(formats)
+
+	if want := []string{"text/html", "text/plain"}; !slices.Equal(formats, want) {
+		t.Errorf("Lookup(/) found formats %q, want %q", formats, want)
+	}
+
+	for _, name := range []string{"/upper/only", "/lower/only"} {
+		if _, err := templates.Lookup(name); err != nil {
+			t.Errorf("Lookup(%q): %v", name, err)
+		}
+	}
+
+	// Overridden templates are rendered from the upper source.
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", middleware.JSONFormat)
+		w.Write([]byte("{}"))
+	})
+
+	for accept, want := range map[string]string{
+		"text/html":  "custom index",
+		"text/plain": "embedded text index",
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept", accept)
+
+		w := httptest.NewRecorder()
+		middleware.RenderMiddleware(templates, api)(http.NotFoundHandler()).ServeHTTP(w, r)
+
+		if w.Body.String() != want {
+			t.Errorf("rendered %q as %q, want %q", accept, w.Body, want)
+		}
+	}
+}
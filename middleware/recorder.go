@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// responseRecorder is an in-memory [http.ResponseWriter], used to capture the
+// responses of handlers invoked in-process.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+// newResponseRecorder creates an empty response recorder.
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{
+		header: make(http.Header),
+	}
+}
+
+// Header returns the response headers.
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+// Write appends to the response body, implicitly writing an OK status.
+func (r *responseRecorder) Write(bs []byte) (int, error) {
+	if r.status == 0 {
+		r.WriteHeader(http.StatusOK)
+	}
+
+	return r.body.Write(bs)
+}
+
+// WriteHeader records the response status. Only the first status written is
+// kept.
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+// Status returns the response status.
+func (r *responseRecorder) Status() int {
+	if r.status == 0 {
+		return http.StatusOK
+	}
+
+	return r.status
+}
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// ReloadingTemplates provides templates which are reloaded whenever their
+// files change, such as templates in a directory on disk. It is intended for
+// developing templates without restarting the server.
+type ReloadingTemplates struct {
+	source      TemplateSource
+	rootDir     string
+	current     atomic.Pointer[Templates]
+	fingerprint uint64
+}
+
+// WatchTemplates loads the templates in the root directory of the source, and
+// polls it for changes at the given interval until the context is cancelled.
+//
+// If reloading fails, e.g. because a template has a syntax error, the error is
+// logged and the previous templates are kept.
+func WatchTemplates(
+	ctx context.Context,
+	ts TemplateSource,
+	rootDir string,
+	interval time.Duration,
+) (*ReloadingTemplates, error) {
+	t := &ReloadingTemplates{
+		source:  ts,
+		rootDir: rootDir,
+	}
+
+	if err := t.reload(); err != nil {
+		return nil, err
+	}
+
+	go t.watch(ctx, interval)
+
+	return t, nil
+}
+
+// Templates returns the most recently loaded templates, implementing
+// [TemplateProvider].
+func (t *ReloadingTemplates) Templates() *Templates {
+	return t.current.Load()
+}
+
+// watch polls the directory for changes until the context is cancelled.
+func (t *ReloadingTemplates) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := t.reload(); err != nil {
+			slog.ErrorContext(
+				ctx,
+				"failed to reload templates",
+				slog.String("directory", t.rootDir),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// reload loads the templates if the directory has changed since they were
+// last loaded.
+func (t *ReloadingTemplates) reload() error {
+	fingerprint, err := t.fingerprintDir()
+	if err != nil {
+		return err
+	}
+
+	if t.current.Load() != nil && fingerprint == t.fingerprint {
+		return nil
+	}
+
+	// The fingerprint is recorded even if loading fails, so that a broken
+	// template is reported once rather than on every poll.
+	t.fingerprint = fingerprint
+
+	templates, err := NewTemplates(t.source, t.rootDir)
+	if err != nil {
+		return err
+	}
+
+	t.current.Store(templates)
+
+	slog.Info("templates loaded", slog.String("directory", t.rootDir))
+
+	return nil
+}
+
+// fingerprintDir hashes the names, sizes and modification times of the files
+// in the directory, so that any change to them changes the fingerprint.
+func (t *ReloadingTemplates) fingerprintDir() (uint64, error) {
+	hash := fnv.New64a()
+
+	if err := fs.WalkDir(t.source, t.rootDir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(hash, "%s\x00%d\x00%d\x00", name, info.Size(), info.ModTime().UnixNano())
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("middleware: scanning template directory %s: %w", t.rootDir, err)
+	}
+
+	return hash.Sum64(), nil
+}
@@ -1,18 +1,23 @@
 package middleware
 
 import (
-	"encoding/base64"
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	htmltemplate "html/template"
 	"io"
 	"io/fs"
 	"log/slog"
 	"net/http"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
-	"text/template"
+	texttemplate "text/template"
+
+	"github.com/go-chi/chi/v5"
 )
 
 var (
@@ -31,6 +36,15 @@ var (
 // I.e. a file named "index.tmpl.html" would be a template file.
 const TemplateExtension = "tmpl"
 
+// LayoutsDir and PartialsDir are the directories, within the root template
+// directory, holding templates shared by every template of the same format.
+// They define named templates, such as a page skeleton or a fragment, which
+// other templates invoke, and are not themselves rendered for any path.
+const (
+	LayoutsDir  = "layouts"
+	PartialsDir = "partials"
+)
+
 // TemplateSource is an interface that combines the capabilities of fs.ReadDirFS
 // and fs.ReadFileFS.
 // It represents a source from which templates can be read, allowing directory
@@ -45,23 +59,36 @@ type Template struct {
 	Format    string
 	Templated bool
 	Path      string
+
+	// contents holds the file of a template which is not templated, and
+	// executor the parsed template otherwise.
+	contents []byte
+	executor executor
 }
 
 // Templates represents a collection of templates, which can be looked up by
 // name.
+//
+// Templates are read and parsed when the collection is created, so a
+// collection never changes once created.
 type Templates struct {
 	fs            fs.ReadFileFS
 	templateNames map[string][]Template
+
+	// errorTemplates holds the templates of the errors directory, by their
+	// name within it, e.g. "404" or "error".
+	errorTemplates map[string][]Template
 }
 
-// DecodeBase64 decodes a base64-encoded string.
-func DecodeBase64(str string) (string, error) {
-	bs, err := base64.StdEncoding.DecodeString(str)
-	if err != nil {
-		return "", fmt.Errorf("middleware: decoding template base64: %w", err)
-	}
+// TemplateProvider provides the current collection of templates, which may
+// change over time, e.g. as templates are reloaded.
+type TemplateProvider interface {
+	Templates() *Templates
+}
 
-	return string(bs), nil
+// Templates returns the collection itself, implementing [TemplateProvider].
+func (t *Templates) Templates() *Templates {
+	return t
 }
 
 // NewTemplates creates a new instance of Templates, using the given file system
@@ -70,10 +97,16 @@ func NewTemplates(ts TemplateSource, rootDir string) (*Templates, error) {
 	rootDir = path.Clean(rootDir)
 
 	templates := &Templates{
-		fs:            ts,
-		templateNames: make(map[string][]Template),
+		fs:             ts,
+		templateNames:  make(map[string][]Template),
+		errorTemplates: make(map[string][]Template),
 	}
 
+	var pages []namedTemplate
+
+	// Templates shared by all templates of each format.
+	shared := make(map[string][][]byte)
+
 	// Walk the directory to get all the templates.
 	if err := fs.WalkDir(ts, rootDir, func(fullpath string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -92,7 +125,18 @@ func NewTemplates(ts TemplateSource, rootDir string) (*Templates, error) {
 		}
 
 		for name, template := range dirTemplates {
-			templates.templateNames[name] = append(templates.templateNames[name], template)
+			if !isShared(name) {
+				pages = append(pages, namedTemplate{name, template})
+
+				continue
+			}
+
+			contents, err := ts.ReadFile(template.Path)
+			if err != nil {
+				return fmt.Errorf("middleware: reading template %s: %w", template.Path, err)
+			}
+
+			shared[template.Format] = append(shared[template.Format], contents)
 		}
 
 		return nil
@@ -100,9 +144,37 @@ func NewTemplates(ts TemplateSource, rootDir string) (*Templates, error) {
 		return nil, fmt.Errorf("middleware: walking template directory %s: %w", rootDir, err)
 	}
 
+	for _, page := range pages {
+		if err := page.template.load(ts, shared[page.template.Format]); err != nil {
+			return nil, err
+		}
+
+		if errorName, ok := strings.CutPrefix(filepath.ToSlash(page.name), ErrorsDir+"/"); ok {
+			templates.errorTemplates[errorName] = append(templates.errorTemplates[errorName], page.template)
+
+			continue
+		}
+
+		templates.templateNames[page.name] = append(templates.templateNames[page.name], page.template)
+	}
+
 	return templates, nil
 }
 
+// namedTemplate is a template, and the name that it is looked up by.
+type namedTemplate struct {
+	name     string
+	template Template
+}
+
+// isShared reports whether the named template is a layout or partial, shared
+// by other templates.
+func isShared(name string) bool {
+	dir, _, _ := strings.Cut(filepath.ToSlash(name), "/")
+
+	return dir == LayoutsDir || dir == PartialsDir
+}
+
 // templatesFromDirentry reads a file directory entry and returns a map of
 // templates found within it.
 func templatesFromDirentry(
@@ -151,42 +223,96 @@ func templatesFromDirentry(
 	}, nil
 }
 
-// Open opens a template file for reading.
-func (t Templates) Open(name string) (*Template, io.ReadCloser, error) {
+// load reads the template file, parsing it alongside the shared layouts and
+// partials if it is templated.
+func (t *Template) load(fsys fs.ReadFileFS, shared [][]byte) error {
+	contents, err := fsys.ReadFile(t.Path)
+	if err != nil {
+		return fmt.Errorf("middleware: reading template %s: %w", t.Path, err)
+	}
+
+	if !t.Templated {
+		t.contents = contents
+
+		return nil
+	}
+
+	t.executor, err = parseTemplate(t.Format, shared, contents)
+	if err != nil {
+		return fmt.Errorf("middleware: parsing template %s: %w", t.Path, err)
+	}
+
+	return nil
+}
+
+// Lookup returns the templates with the given name, one for each format that
+// it can be rendered in.
+func (t *Templates) Lookup(name string) ([]Template, error) {
 	templateName, err := filepath.Rel("/", path.Clean(name))
 	if err != nil {
-		return nil, nil, fmt.Errorf("middleware: getting relative path: %w", err)
+		return nil, fmt.Errorf("middleware: getting relative path: %w", err)
 	}
 
-	template, ok := t.templateNames[templateName]
+	templates, ok := t.templateNames[templateName]
 	if !ok {
-		return nil, nil, ErrNoTemplateFound
+		return nil, ErrNoTemplateFound
 	}
 
-	templateFile, err := t.fs.Open(template[0].Path)
-	if err != nil {
-		return nil, nil, fmt.Errorf(
-			"middleware: opening template file %s: %w",
-			template[0].Path,
-			err,
-		)
+	return templates, nil
+}
+
+// executor is a parsed template, of either the text/template or html/template
+// packages.
+type executor interface {
+	Execute(w io.Writer, data any) error
+}
+
+// parseTemplate parses a template rendering the given format, after the shared
+// templates that it may invoke. The template may redefine the named templates
+// of the shared templates, e.g. to fill the blocks of a layout.
+//
+// HTML is rendered with html/template, so that values such as log contents are
+// escaped according to their context, and other formats with text/template.
+func parseTemplate(format string, shared [][]byte, contents []byte) (executor, error) {
+	if format == extensionMimeType["html"] {
+		t := htmltemplate.New("response").Funcs(htmltemplate.FuncMap(templateFuncs))
+
+		for _, sharedContents := range shared {
+			if _, err := t.Parse(string(sharedContents)); err != nil {
+				return nil, err
+			}
+		}
+
+		return t.Parse(string(contents))
 	}
 
-	return &template[0], templateFile, nil
+	t := texttemplate.New("response").Funcs(texttemplate.FuncMap(templateFuncs))
+
+	for _, sharedContents := range shared {
+		if _, err := t.Parse(string(sharedContents)); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.Parse(string(contents))
 }
 
 // RenderMiddleware makes a request to the API for the given path, if not
 // already an API request, and renders the response.
+//
+// A path may have templates in several formats, which are chosen between by
+// the Accept header or format query parameter of the request. The API
+// response itself is served if JSON is preferred.
+//
+// The API request is dispatched to the given handler in-process, carrying the
+// context of the original request, such as the authenticated user. The handler
+// should serve the API alone, as the original request has already passed
+// through any middleware, such as authentication and auditing.
 func RenderMiddleware(
-	templateSource TemplateSource,
-	templateDir string,
+	templates TemplateProvider,
+	api http.Handler,
 ) func(next http.Handler) http.Handler {
-	templates, err := NewTemplates(templateSource, templateDir)
-	if err != nil {
-		panic(err)
-	}
-
-	for name, templates := range templates.templateNames {
+	for name, templates := range templates.Templates().templateNames {
 		for _, template := range templates {
 			slog.Info(
 				"found template",
@@ -197,38 +323,6 @@ func RenderMiddleware(
 		}
 	}
 
-	// GetTemplate reads a template from the templates directory.
-	GetTemplate := func(name string) (*Template, []byte, error) {
-		templatePath := path.Clean(name)
-
-		template, templateFile, err := templates.Open(templatePath)
-		if err != nil {
-			return nil, nil, fmt.Errorf(
-				"middleware: opening template file %s: %w",
-				templatePath,
-				err,
-			)
-		}
-
-		defer templateFile.Close()
-
-		templateContents, err := io.ReadAll(templateFile)
-		if err != nil {
-			return nil, nil, fmt.Errorf(
-				"middleware: reading template %s: %w",
-				name,
-				err,
-			)
-		}
-
-		return template, templateContents, nil
-	}
-
-	// StandardError responds with a standard error message.
-	StandardError := func(w http.ResponseWriter, status int) {
-		http.Error(w, http.StatusText(status), status)
-	}
-
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			cleanPath := path.Clean(r.URL.Path)
@@ -241,20 +335,20 @@ func RenderMiddleware(
 
 			// If the request has a specific file extension, return a 404.
 			if path.Ext(cleanPath) != "" {
-				StandardError(w, http.StatusNotFound)
+				renderError(w, r, templates.Templates(), http.StatusNotFound, nil)
 
 				return
 			}
 
 			if r.Method != http.MethodGet {
-				StandardError(w, http.StatusMethodNotAllowed)
+				renderError(w, r, templates.Templates(), http.StatusMethodNotAllowed, nil)
 
 				return
 			}
 
-			templateDetails, templateContent, err := GetTemplate(cleanPath)
+			candidates, err := templates.Templates().Lookup(cleanPath)
 			if errors.Is(err, ErrNoTemplateFound) {
-				StandardError(w, http.StatusNotFound)
+				renderError(w, r, templates.Templates(), http.StatusNotFound, nil)
 
 				return
 			} else if err != nil {
@@ -264,79 +358,67 @@ func RenderMiddleware(
 					slog.Any("error", err),
 				)
 
-				StandardError(w, http.StatusInternalServerError)
+				renderError(w, r, templates.Templates(), http.StatusInternalServerError, nil)
 
 				return
 			}
 
-			// If the template is not a template, return it as is.
-			if !templateDetails.Templated {
-				w.Header().Set("Content-Type", templateDetails.Format)
-				w.Write(templateContent)
+			formats := make([]string, 0, len(candidates)+1)
 
-				return
+			for _, candidate := range candidates {
+				formats = append(formats, candidate.Format)
 			}
 
-			// Build the response template.
-			responseTemplate := template.New("response")
-
-			responseTemplate.Funcs(template.FuncMap{
-				"from_base64": DecodeBase64,
-			})
+			// Rendered templates can also be requested as the API response
+			// that they are rendered from.
+			if slices.ContainsFunc(candidates, func(candidate Template) bool {
+				return candidate.Templated
+			}) {
+				formats = append(formats, JSONFormat)
+			}
 
-			_, err = responseTemplate.Parse(string(templateContent))
-			if err != nil {
-				slog.ErrorContext(
-					r.Context(),
-					"failed to parse template",
-					slog.Any("error", err),
-				)
+			w.Header().Add("Vary", "Accept")
 
-				http.Error(w, "Failed to parse template", http.StatusInternalServerError)
+			format, ok := negotiate(r, formats)
+			if !ok {
+				renderError(w, r, templates.Templates(), http.StatusNotAcceptable, nil)
 
 				return
 			}
 
-			apiRequest := r.Clone(r.Context())
-			apiRequest.RequestURI = ""
-			apiRequest.URL.Scheme = "http"
-			apiRequest.URL.Path = path.Join("/api", cleanPath)
-
-			// Make the request to the API.
-			apiResponse, err := http.DefaultClient.Do(apiRequest)
-			if err != nil {
-				slog.ErrorContext(
-					r.Context(),
-					"failed to make API request",
-					slog.Any("error", err),
-				)
-
-				http.Error(w, "Failed to make API request", http.StatusInternalServerError)
+			if format == JSONFormat {
+				api.ServeHTTP(w, apiRequest(r, path.Join("/api", cleanPath)))
 
 				return
 			}
 
-			defer apiResponse.Body.Close()
+			templateDetails := &candidates[slices.Index(formats, format)]
 
-			if apiResponse.StatusCode == http.StatusNotFound {
-				http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			// If the template is not a template, return it as is.
+			if !templateDetails.Templated {
+				w.Header().Set("Content-Type", templateDetails.Format)
+				w.Write(templateDetails.contents)
 
 				return
 			}
 
-			if apiResponse.StatusCode < http.StatusOK || apiResponse.StatusCode >= http.StatusMultipleChoices {
-				slog.ErrorContext(
+			apiResponse := newResponseRecorder()
+
+			api.ServeHTTP(apiResponse, apiRequest(r, path.Join("/api", cleanPath)))
+
+			if apiResponse.Status() < http.StatusOK || apiResponse.Status() >= http.StatusMultipleChoices {
+				slog.InfoContext(
 					r.Context(),
 					"API request failed",
-					slog.Any("status_code", apiResponse.StatusCode),
+					slog.Any("status_code", apiResponse.Status()),
 				)
 
-				http.Error(w, "API request failed", apiResponse.StatusCode)
+				renderError(w, r, templates.Templates(), apiResponse.Status(), decodeAPIError(apiResponse))
 
 				return
 			}
 
-			contentType := apiResponse.Header.Get("Content-Type")
+			contentType := apiResponse.Header().Get("Content-Type")
 			if contentType != "application/json" {
 				slog.ErrorContext(
 					r.Context(),
@@ -344,20 +426,22 @@ func RenderMiddleware(
 					slog.String("content_type", contentType),
 				)
 
-				http.Error(w, "API response is not JSON", http.StatusInternalServerError)
+				renderError(w, r, templates.Templates(), http.StatusInternalServerError, nil)
+
+				return
 			}
 
 			// Must decode to a map, to allow rendering the template.
 			var decodedResponse map[string]any
 
-			if err := json.NewDecoder(apiResponse.Body).Decode(&decodedResponse); err != nil {
+			if err := json.NewDecoder(&apiResponse.body).Decode(&decodedResponse); err != nil {
 				slog.ErrorContext(
 					r.Context(),
 					"failed to decode API response",
 					slog.Any("error", err),
 				)
 
-				http.Error(w, "Failed to decode API response", http.StatusInternalServerError)
+				renderError(w, r, templates.Templates(), http.StatusInternalServerError, nil)
 
 				return
 			}
@@ -365,20 +449,39 @@ func RenderMiddleware(
 			// Add some useful utilities.
 			decodedResponse["Request"] = r.URL
 
-			w.Header().Set("Content-Type", templateDetails.Format)
+			// Render the response template, buffering it so that an error page
+			// can still be served if rendering fails partway through.
+			var rendered bytes.Buffer
 
-			// Render the response template.
-			if err := responseTemplate.Execute(w, decodedResponse); err != nil {
+			if err := templateDetails.executor.Execute(&rendered, decodedResponse); err != nil {
 				slog.ErrorContext(
 					r.Context(),
 					"failed to render template",
 					slog.Any("error", err),
 				)
 
-				http.Error(w, "Failed to render template", http.StatusInternalServerError)
+				renderError(w, r, templates.Templates(), http.StatusInternalServerError, nil)
 
 				return
 			}
+
+			w.Header().Set("Content-Type", templateDetails.Format)
+			w.Write(rendered.Bytes())
 		})
 	}
 }
+
+// apiRequest creates the request to the API for the given path, with the query,
+// headers and context of the original request.
+func apiRequest(r *http.Request, apiPath string) *http.Request {
+	// The router's state for the original request must not be reused, so that
+	// the API request is routed afresh.
+	ctx := context.WithValue(r.Context(), chi.RouteCtxKey, nil)
+
+	apiRequest := r.Clone(ctx)
+	apiRequest.URL.Path = apiPath
+	apiRequest.URL.RawPath = ""
+	apiRequest.RequestURI = apiRequest.URL.RequestURI()
+
+	return apiRequest
+}
@@ -0,0 +1,75 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/crystalix007/log-viewer/middleware"
+)
+
+func TestTemplateLayouts(t *testing.T) {
+	templates, err := middleware.NewTemplates(fstest.MapFS{
+		"layouts/base.tmpl.html": {Data: []byte(
+			`{{ define "base" }}<html>{{ template "content" . }}{{ template "footer" . }}</html>{{ end }}`,
+		)},
+		"partials/footer.tmpl.html": {Data: []byte(
+			`{{ define "footer" }}<footer>{{ human_size .size }}</footer>{{ end }}`,
+		)},
+		"partials/footer.tmpl.txt": {Data: []byte(
+			`{{ define "footer" }}({{ human_size .size }}){{ end }}`,
+		)},
+		"log/index.tmpl.html": {Data: []byte(
+			`{{ template "base" . }}{{ define "content" }}<p>{{ from_base64 .content }}</p>{{ end }}`,
+		)},
+		"log/index.tmpl.txt": {Data: []byte(
+			`{{ from_base64 .content }} {{ template "footer" . }}`,
+		)},
+		"logs/index.tmpl.html": {Data: []byte(
+			`{{ template "base" . }}{{ define "content" }}<ul><li>{{ .name }}</li></ul>{{ end }}`,
+		)},
+	}, ".")
+	if err != nil {
+		t.Fatalf("NewTemplates: %v", err)
+	}
+
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", middleware.JSONFormat)
+		w.Write([]byte(`{"name": "<app.log>", "content": "PGI+aGk8L2I+", "size": 2048}`))
+	})
+
+	handler := middleware.RenderMiddleware(templates, api)(http.NotFoundHandler())
+
+	tests := []struct {
+		target string
+		accept string
+		want   string
+	}{
+		{"/log", "text/html", "<html><p>&lt;b&gt;hi&lt;/b&gt;</p><footer>2.0 KiB</footer></html>"},
+		{"/log", "text/plain", "<b>hi</b> (2.0 KiB)"},
+		{"/logs", "text/html", "<html><ul><li>&lt;app.log&gt;</li></ul><footer>2.0 KiB</footer></html>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.target+" "+test.accept, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, test.target, nil)
+			r.Header.Set("Accept", test.accept)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != http.StatusOK || w.Body.String() != test.want {
+				t.Errorf("GET %s = %d %q, want %q", test.target, w.Code, w.Body, test.want)
+			}
+		})
+	}
+
+	// Layouts and partials are not pages themselves.
+	for _, name := range []string{"/layouts/base", "/partials/footer"} {
+		if _, err := templates.Lookup(name); !errors.Is(err, middleware.ErrNoTemplateFound) {
+			t.Errorf("Lookup(%q) returned %v, want %v", name, err, middleware.ErrNoTemplateFound)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrInvalidSession is returned when a session cookie has been tampered with,
+// or has expired.
+var ErrInvalidSession = errors.New("middleware: invalid session")
+
+// defaultSessionDuration is the length of time a session lasts, if not
+// configured.
+const defaultSessionDuration = 12 * time.Hour
+
+// Sessions issues and verifies signed session cookies.
+type Sessions struct {
+	key        []byte
+	cookieName string
+	duration   time.Duration
+}
+
+// session is the signed contents of a session cookie.
+type session struct {
+	User    User      `json:"user"`
+	Expires time.Time `json:"expires"`
+}
+
+// NewSessions creates a new session store, signing cookies with the given
+// key. If the key is empty, a random key is generated, so sessions will not
+// survive a restart.
+func NewSessions(cookieName string, key []byte, duration time.Duration) (*Sessions, error) {
+	if len(key) == 0 {
+		key = make([]byte, 32)
+
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("middleware: generating session key: %w", err)
+		}
+	}
+
+	if duration <= 0 {
+		duration = defaultSessionDuration
+	}
+
+	return &Sessions{
+		key:        key,
+		cookieName: cookieName,
+		duration:   duration,
+	}, nil
+}
+
+// Get returns the user of the request's session.
+func (s *Sessions) Get(r *http.Request) (User, error) {
+	cookie, err := r.Cookie(s.cookieName)
+	if err != nil {
+		return User{}, ErrNoCredentials
+	}
+
+	var sess session
+
+	if err := s.verify(cookie.Value, &sess); err != nil {
+		return User{}, err
+	}
+
+	if time.Now().After(sess.Expires) {
+		return User{}, fmt.Errorf("%w: expired", ErrInvalidSession)
+	}
+
+	return sess.User, nil
+}
+
+// Set starts a new session for the user.
+func (s *Sessions) Set(w http.ResponseWriter, r *http.Request, user User) error {
+	expires := time.Now().Add(s.duration)
+
+	return s.setCookie(w, r, s.cookieName, session{
+		User:    user,
+		Expires: expires,
+	}, expires)
+}
+
+// Clear ends the request's session.
+func (s *Sessions) Clear(w http.ResponseWriter, r *http.Request) {
+	clearCookie(w, r, s.cookieName)
+}
+
+// setCookie sets a cookie holding the signed value.
+func (s *Sessions) setCookie(
+	w http.ResponseWriter,
+	r *http.Request,
+	name string,
+	value any,
+	expires time.Time,
+) error {
+	signed, err := s.sign(value)
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    signed,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		Secure:   isSecure(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return nil
+}
+
+// sign encodes the value as JSON, appending an HMAC of the encoding.
+func (s *Sessions) sign(value any) (string, error) {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("middleware: encoding session: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// verify checks the HMAC of a signed value, decoding it into value.
+func (s *Sessions) verify(signed string, value any) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(signed, ".")
+	if !ok {
+		return ErrInvalidSession
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrInvalidSession
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return ErrInvalidSession
+	}
+
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrInvalidSession
+	}
+
+	if err := json.Unmarshal(payload, value); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSession, err)
+	}
+
+	return nil
+}
+
+// clearCookie instructs the client to delete the named cookie.
+func clearCookie(w http.ResponseWriter, r *http.Request, name string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   isSecure(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// isSecure reports whether the client connected over TLS, either directly or
+// through a proxy.
+func isSecure(r *http.Request) bool {
+	return r.TLS != nil || strings.EqualFold(r.Header.Get("X-Forwarded-Proto"), "https")
+}
@@ -0,0 +1,70 @@
+package redact
+
+import "regexp"
+
+// builtins are the detectors for common secrets and personal data. Detectors
+// for structured secrets come first, so that e.g. a JWT in a bearer token is
+// reported once.
+var builtins = []Detector{
+	{
+		Name:    "bearer-token",
+		Pattern: regexp.MustCompile(`(?i)\bbearer\s+(?P<secret>[A-Za-z0-9\-._~+/]+=*)`),
+	},
+	{
+		Name:    "jwt",
+		Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	},
+	{
+		Name:    "url-password",
+		Pattern: regexp.MustCompile(`\b[A-Za-z][A-Za-z0-9+.-]*://[^\s:/@]+:(?P<secret>[^\s/@]+)@`),
+	},
+	{
+		Name: "password",
+		Pattern: regexp.MustCompile(
+			`(?i)\b(?:password|passwd|pwd|secret|api[_-]?key|access[_-]?token)\s*[=:]\s*(?P<secret>[^\s"',&;]+)`,
+		),
+	},
+	{
+		Name:    "aws-access-key",
+		Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`),
+	},
+	{
+		Name:    "email",
+		Pattern: regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`),
+	},
+	{
+		Name:    "card-number",
+		Pattern: regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`),
+		Valid:   luhn,
+	},
+}
+
+// luhn reports whether the digits of a number pass the Luhn checksum used by
+// payment card numbers.
+func luhn(number []byte) bool {
+	var (
+		sum    int
+		double bool
+	)
+
+	for i := len(number) - 1; i >= 0; i-- {
+		if number[i] < '0' || number[i] > '9' {
+			continue
+		}
+
+		digit := int(number[i] - '0')
+
+		if double {
+			digit *= 2
+
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
@@ -0,0 +1,201 @@
+// Package redact masks secrets and personal data in log lines before they are
+// shown to users.
+package redact
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrUnknownDetector is returned when a built-in detector is requested by
+	// a name that does not exist.
+	ErrUnknownDetector = errors.New("redact: unknown detector")
+
+	// ErrInvalidPattern is returned when a user-supplied pattern is not a
+	// valid regular expression.
+	ErrInvalidPattern = errors.New("redact: invalid pattern")
+)
+
+// Mask replaces redacted content.
+const Mask = "[REDACTED]"
+
+// secretGroup is the name of the capture group holding the sensitive part of a
+// detector's match. Without one, the whole match is redacted.
+const secretGroup = "secret"
+
+// Detector finds one kind of sensitive content in log lines.
+type Detector struct {
+	Name    string
+	Pattern *regexp.Regexp
+
+	// Replacement replaces the sensitive content, defaulting to [Mask].
+	Replacement string
+
+	// Valid, if set, filters matches which are not really sensitive, e.g.
+	// numbers which fail a checksum.
+	Valid func(match []byte) bool
+}
+
+// Redactor applies a set of detectors to log lines.
+type Redactor struct {
+	detectors []Detector
+}
+
+// New creates a redactor applying the given detectors in order.
+func New(detectors ...Detector) *Redactor {
+	return &Redactor{
+		detectors: detectors,
+	}
+}
+
+// PatternDetector creates a detector from a user-supplied regular expression.
+// If it has a capture group named "secret", only that group is redacted.
+func PatternDetector(pattern string) (Detector, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return Detector{}, fmt.Errorf("%w: %w", ErrInvalidPattern, err)
+	}
+
+	return Detector{
+		Name:    "pattern",
+		Pattern: re,
+	}, nil
+}
+
+// FieldDetector creates a detector redacting the values of the named fields in
+// JSON log lines, matching field names case-insensitively.
+func FieldDetector(fields ...string) Detector {
+	quoted := make([]string, len(fields))
+
+	for i, field := range fields {
+		quoted[i] = regexp.QuoteMeta(field)
+	}
+
+	return Detector{
+		Name: "field",
+		Pattern: regexp.MustCompile(
+			`(?i)"(?:` + strings.Join(quoted, "|") + `)"\s*:\s*(?P<secret>"(?:[^"\\]|\\.)*"|[^\s,}\]]+)`,
+		),
+		// The mask is quoted so that redacted lines remain valid JSON.
+		Replacement: `"` + Mask + `"`,
+	}
+}
+
+// Redact masks the sensitive content of a line, reporting whether anything
+// was masked.
+func (r *Redactor) Redact(line []byte) ([]byte, bool) {
+	var redacted bool
+
+	for _, detector := range r.detectors {
+		var matched bool
+
+		line, matched = detector.redact(line)
+		redacted = redacted || matched
+	}
+
+	return line, redacted
+}
+
+// RedactLines masks the sensitive content of each line of a log, reporting
+// whether anything was masked.
+func (r *Redactor) RedactLines(bs []byte) ([]byte, bool) {
+	lines := bytes.Split(bs, []byte("\n"))
+
+	var redacted bool
+
+	for i, line := range lines {
+		var matched bool
+
+		lines[i], matched = r.Redact(line)
+		redacted = redacted || matched
+	}
+
+	return bytes.Join(lines, []byte("\n")), redacted
+}
+
+// redact masks the detector's matches in the line.
+func (d Detector) redact(line []byte) ([]byte, bool) {
+	matches := d.Pattern.FindAllSubmatchIndex(line, -1)
+	if len(matches) == 0 {
+		return line, false
+	}
+
+	replacement := d.Replacement
+	if replacement == "" {
+		replacement = Mask
+	}
+
+	group := d.Pattern.SubexpIndex(secretGroup)
+
+	var (
+		result   []byte
+		last     int
+		redacted bool
+	)
+
+	for _, match := range matches {
+		start, end := match[0], match[1]
+
+		if group > 0 {
+			start, end = match[2*group], match[2*group+1]
+
+			// The secret is optional in the pattern, and absent here.
+			if start < 0 {
+				continue
+			}
+		}
+
+		if d.Valid != nil && !d.Valid(line[start:end]) {
+			continue
+		}
+
+		result = append(result, line[last:start]...)
+		result = append(result, replacement...)
+		last = end
+		redacted = true
+	}
+
+	if !redacted {
+		return line, false
+	}
+
+	return append(result, line[last:]...), true
+}
+
+// DetectorNames returns the names of the built-in detectors.
+func DetectorNames() []string {
+	names := make([]string, len(builtins))
+
+	for i, detector := range builtins {
+		names[i] = detector.Name
+	}
+
+	return names
+}
+
+// Builtin returns the named built-in detectors, or all of them given "all".
+func Builtin(names ...string) ([]Detector, error) {
+	if slices.Contains(names, "all") {
+		return slices.Clone(builtins), nil
+	}
+
+	detectors := make([]Detector, 0, len(names))
+
+	for _, name := range names {
+		index := slices.IndexFunc(builtins, func(d Detector) bool {
+			return d.Name == name
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownDetector, name)
+		}
+
+		detectors = append(detectors, builtins[index])
+	}
+
+	return detectors, nil
+}
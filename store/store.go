@@ -0,0 +1,166 @@
+// Package store persists small collections of records, such as annotations,
+// as JSON files within a state directory.
+//
+// Each collection is held in memory and rewritten in full on every change,
+// replacing the previous file atomically, so a crash never leaves a collection
+// partially written.
+package store
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrNotFound is returned when a record does not exist in a collection.
+var ErrNotFound = errors.New("store: record not found")
+
+// Collection is a persistent collection of records of type T, keyed by ID.
+type Collection[T any] struct {
+	mu       sync.RWMutex
+	filename string
+	records  map[string]T
+}
+
+// Open opens the named collection in the directory, loading its records if it
+// has been written before. The file is only created once a record is stored.
+func Open[T any](dir string, name string) (*Collection[T], error) {
+	c := &Collection[T]{
+		filename: filepath.Join(dir, name+".json"),
+		records:  make(map[string]T),
+	}
+
+	contents, err := os.ReadFile(c.filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("store: reading %s: %w", c.filename, err)
+	}
+
+	if err := json.Unmarshal(contents, &c.records); err != nil {
+		return nil, fmt.Errorf("store: decoding %s: %w", c.filename, err)
+	}
+
+	return c, nil
+}
+
+// NewID returns a new random ID for a record.
+func NewID() string {
+	id := make([]byte, 8)
+	rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
+
+// Get returns the record with the given ID.
+func (c *Collection[T]) Get(id string) (T, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	record, ok := c.records[id]
+	if !ok {
+		return record, ErrNotFound
+	}
+
+	return record, nil
+}
+
+// List returns all the records, by ID.
+func (c *Collection[T]) List() map[string]T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return maps.Clone(c.records)
+}
+
+// Put stores the record with the given ID, replacing any existing record.
+func (c *Collection[T]) Put(id string, record T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	previous, existed := c.records[id]
+	c.records[id] = record
+
+	if err := c.write(); err != nil {
+		if existed {
+			c.records[id] = previous
+		} else {
+			delete(c.records, id)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Delete removes the record with the given ID.
+func (c *Collection[T]) Delete(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	record, ok := c.records[id]
+	if !ok {
+		return ErrNotFound
+	}
+
+	delete(c.records, id)
+
+	if err := c.write(); err != nil {
+		c.records[id] = record
+
+		return err
+	}
+
+	return nil
+}
+
+// write replaces the file of the collection with its current records, by
+// writing a temporary file alongside it and renaming it into place. The
+// directory of the file is created if it does not exist.
+func (c *Collection[T]) write() error {
+	contents, err := json.MarshalIndent(c.records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("store: encoding %s: %w", c.filename, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.filename), 0o700); err != nil {
+		return fmt.Errorf("store: writing %s: %w", c.filename, err)
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(c.filename), filepath.Base(c.filename)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("store: writing %s: %w", c.filename, err)
+	}
+
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(contents); err != nil {
+		temp.Close()
+
+		return fmt.Errorf("store: writing %s: %w", c.filename, err)
+	}
+
+	if err := temp.Sync(); err != nil {
+		temp.Close()
+
+		return fmt.Errorf("store: writing %s: %w", c.filename, err)
+	}
+
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("store: writing %s: %w", c.filename, err)
+	}
+
+	if err := os.Rename(temp.Name(), c.filename); err != nil {
+		return fmt.Errorf("store: writing %s: %w", c.filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package structured
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFilter is returned when a filter is not of the form "path=value".
+var ErrInvalidFilter = errors.New("structured: filter must be of the form path=value")
+
+// Filter matches the records in which the field at a path has a value, e.g.
+// "level=error" or "http.status=500".
+type Filter struct {
+	Path  string
+	Value string
+}
+
+// ParseFilter parses a filter of the form "path=value".
+func ParseFilter(filter string) (Filter, error) {
+	path, value, found := strings.Cut(filter, "=")
+	if !found || path == "" {
+		return Filter{}, fmt.Errorf("%w: %q", ErrInvalidFilter, filter)
+	}
+
+	return Filter{
+		Path:  path,
+		Value: value,
+	}, nil
+}
+
+// String returns the filter in the form "path=value".
+func (f Filter) String() string {
+	return f.Path + "=" + f.Value
+}
+
+// Match reports whether the line is a structured record in which the field at
+// the filter's path has the filter's value. Objects and arrays never match.
+func (f Filter) Match(line []byte) bool {
+	values, ok := decode(line)
+	if !ok {
+		return false
+	}
+
+	value, ok := lookup(values, f.Path)
+	if !ok {
+		return false
+	}
+
+	switch value.(type) {
+	case map[string]any, []any:
+		return false
+	default:
+		return format(value) == f.Value
+	}
+}
+
+// MatchAll reports whether the line matches all of the filters.
+func MatchAll(filters []Filter, line []byte) bool {
+	for _, filter := range filters {
+		if !filter.Match(line) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Lookup returns the text of the field at a dotted path within a structured
+// record, as it is matched by filters, reporting whether the line has the
+// field. Objects and arrays are returned as JSON.
+func Lookup(line []byte, path string) (string, bool) {
+	values, ok := decode(line)
+	if !ok {
+		return "", false
+	}
+
+	value, ok := lookup(values, path)
+	if !ok {
+		return "", false
+	}
+
+	switch value.(type) {
+	case map[string]any, []any:
+		text, err := json.Marshal(value)
+		if err != nil {
+			return "", false
+		}
+
+		return string(text), true
+	default:
+		return format(value), true
+	}
+}
+
+// lookup returns the value at a dotted path within an object. Keys containing
+// dots, such as "log.level", are matched literally before being descended
+// into.
+func lookup(values map[string]any, path string) (any, bool) {
+	if value, ok := values[path]; ok {
+		return value, true
+	}
+
+	for i := range len(path) {
+		if path[i] != '.' {
+			continue
+		}
+
+		child, ok := values[path[:i]]
+		if !ok {
+			continue
+		}
+
+		switch child := child.(type) {
+		case map[string]any:
+			if value, ok := lookup(child, path[i+1:]); ok {
+				return value, true
+			}
+		case []any:
+			if value, ok := lookupIndex(child, path[i+1:]); ok {
+				return value, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// lookupIndex returns the value at a dotted path within an array, the first
+// segment of which is an index.
+func lookupIndex(values []any, path string) (any, bool) {
+	index, rest, nested := strings.Cut(path, ".")
+
+	for i, value := range values {
+		if strconv.Itoa(i) != index {
+			continue
+		}
+
+		if !nested {
+			return value, true
+		}
+
+		switch value := value.(type) {
+		case map[string]any:
+			return lookup(value, rest)
+		case []any:
+			return lookupIndex(value, rest)
+		}
+	}
+
+	return nil, false
+}
+
+// kindOf returns the kind of a scalar value.
+func kindOf(value any) Kind {
+	switch value.(type) {
+	case string:
+		return String
+	case json.Number, float64:
+		return Number
+	case bool:
+		return Bool
+	default:
+		return Null
+	}
+}
+
+// format returns the text of a scalar value, as it is matched by filters.
+func format(value any) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprint(value)
+	}
+}
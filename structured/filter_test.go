@@ -0,0 +1,154 @@
+package structured_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/crystalix007/log-viewer/structured"
+)
+
+// testLine is a record with nested objects, arrays and keys containing dots.
+const testLine = `{"level":"error","http":{"status":500,"path":"/api"},"log.level":"warn",` +
+	`"tags":["a",{"name":"b"},["c"]],"user":null,"ok":true,"ratio":1.50,` +
+	`"obj":{"a.b":{"c":1}},"x":{"y":1},"x.y":{"z":2}}`
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   structured.Filter
+	}{
+		{"level=error", structured.Filter{Path: "level", Value: "error"}},
+		{"http.status=500", structured.Filter{Path: "http.status", Value: "500"}},
+		{"msg=a=b", structured.Filter{Path: "msg", Value: "a=b"}},
+		{"msg=", structured.Filter{Path: "msg", Value: ""}},
+	}
+
+	for _, test := range tests {
+		filter, err := structured.ParseFilter(test.filter)
+		if err != nil {
+			t.Errorf("ParseFilter(%q): %v", test.filter, err)
+
+			continue
+		}
+
+		if filter != test.want {
+			t.Errorf("ParseFilter(%q) = %+v, want %+v", test.filter, filter, test.want)
+		}
+
+		if filter.String() != test.filter {
+			t.Errorf("ParseFilter(%q).String() = %q", test.filter, filter.String())
+		}
+	}
+
+	for _, filter := range []string{"", "level", "=error"} {
+		if _, err := structured.ParseFilter(filter); !errors.Is(err, structured.ErrInvalidFilter) {
+			t.Errorf("ParseFilter(%q) returned %v, want %v", filter, err, structured.ErrInvalidFilter)
+		}
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{"level=error", true},
+		{"level=ERROR", false},
+		{"http.status=500", true},
+		{"http.status=500.0", false},
+		{"http.path=/api", true},
+		{"log.level=warn", true},
+		{"tags.0=a", true},
+		{"tags.1.name=b", true},
+		{"tags.2.0=c", true},
+		{"tags.01=a", false},
+		{"tags.-1=a", false},
+		{"tags.3=a", false},
+		{"tags.0.name=a", false},
+		{"user=null", true},
+		{"ok=true", true},
+		{"ratio=1.50", true},
+		{"ratio=1.5", false},
+		{"obj.a.b.c=1", true},
+		{"x.y.z=2", true},
+		// The literal key "x.y" takes precedence over descending into "x".
+		{"x.y=1", false},
+		{"missing=", false},
+		{`http={"path":"/api","status":500}`, false},
+		{`tags=["a",{"name":"b"},["c"]]`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.filter, func(t *testing.T) {
+			filter, err := structured.ParseFilter(test.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := filter.Match([]byte(testLine)); got != test.want {
+				t.Errorf("%q matching %s = %t, want %t", test.filter, testLine, got, test.want)
+			}
+		})
+	}
+
+	if (structured.Filter{Path: "level", Value: "error"}).Match([]byte("level=error msg=failed")) {
+		t.Error("a filter matched an unstructured line")
+	}
+}
+
+func TestMatchAll(t *testing.T) {
+	level := structured.Filter{Path: "level", Value: "error"}
+	status := structured.Filter{Path: "http.status", Value: "500"}
+	missing := structured.Filter{Path: "missing", Value: "x"}
+
+	tests := []struct {
+		filters []structured.Filter
+		want    bool
+	}{
+		{nil, true},
+		{[]structured.Filter{level, status}, true},
+		{[]structured.Filter{level, missing}, false},
+	}
+
+	for _, test := range tests {
+		if got := structured.MatchAll(test.filters, []byte(testLine)); got != test.want {
+			t.Errorf("MatchAll(%v) = %t, want %t", test.filters, got, test.want)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		path   string
+		want   string
+		wantOK bool
+	}{
+		{"level", "error", true},
+		{"http.status", "500", true},
+		{"http", `{"path":"/api","status":500}`, true},
+		{"tags", `["a",{"name":"b"},["c"]]`, true},
+		{"tags.1", `{"name":"b"}`, true},
+		{"tags.1.name", "b", true},
+		{"tags.2.0", "c", true},
+		{"log.level", "warn", true},
+		{"obj.a.b", `{"c":1}`, true},
+		{"user", "null", true},
+		{"ratio", "1.50", true},
+		{"tags.5", "", false},
+		{"http.method", "", false},
+		{"missing", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			got, ok := structured.Lookup([]byte(testLine), test.path)
+			if got != test.want || ok != test.wantOK {
+				t.Errorf("Lookup(%q) = %q, %t, want %q, %t", test.path, got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+
+	if _, ok := structured.Lookup([]byte("level=error"), "level"); ok {
+		t.Error("Lookup found a field of an unstructured line")
+	}
+}
@@ -0,0 +1,68 @@
+package structured
+
+import (
+	"bytes"
+	"unicode"
+)
+
+// Format is the format that a log line is written in.
+type Format string
+
+// The formats of log lines.
+const (
+	// FormatJSON lines are JSON objects, e.g. {"level":"info","msg":"ok"}.
+	FormatJSON Format = "json"
+
+	// FormatLogfmt lines are key=value pairs, e.g. level=info msg=ok.
+	FormatLogfmt Format = "logfmt"
+
+	// FormatText lines are free text.
+	FormatText Format = "text"
+)
+
+// logfmtPairs is the number of key=value pairs that a line must have to be
+// logfmt, rather than text which happens to contain an "=".
+const logfmtPairs = 2
+
+// DetectFormat returns the format that a log line is written in.
+//
+// A line is logfmt if it starts with a key=value pair and has at least one
+// more, as values containing spaces are quoted.
+func DetectFormat(line []byte) Format {
+	if _, ok := decode(line); ok {
+		return FormatJSON
+	}
+
+	var pairs int
+
+	for i, field := range bytes.Fields(line) {
+		if isPair(field) {
+			pairs++
+		} else if i == 0 {
+			return FormatText
+		}
+	}
+
+	if pairs >= logfmtPairs {
+		return FormatLogfmt
+	}
+
+	return FormatText
+}
+
+// isPair reports whether a field is a logfmt key=value pair, whose key is a
+// word such as "level" or "http.status".
+func isPair(field []byte) bool {
+	key, _, found := bytes.Cut(field, []byte("="))
+	if !found || len(key) == 0 {
+		return false
+	}
+
+	for _, r := range string(key) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '.' && r != '-' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,98 @@
+package structured
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+)
+
+// The levels that the levels of log lines are normalised to, from the least
+// to the most severe.
+const (
+	LevelTrace = "trace"
+	LevelDebug = "debug"
+	LevelInfo  = "info"
+	LevelWarn  = "warn"
+	LevelError = "error"
+	LevelFatal = "fatal"
+)
+
+// Levels are the normalised levels, from the least to the most severe.
+var Levels = []string{LevelTrace, LevelDebug, LevelInfo, LevelWarn, LevelError, LevelFatal}
+
+// levelNames are the names that levels are logged with, in lowercase, by the
+// normalised level.
+var levelNames = map[string]string{
+	"trace":    LevelTrace,
+	"debug":    LevelDebug,
+	"info":     LevelInfo,
+	"notice":   LevelInfo,
+	"warn":     LevelWarn,
+	"warning":  LevelWarn,
+	"error":    LevelError,
+	"err":      LevelError,
+	"fatal":    LevelFatal,
+	"panic":    LevelFatal,
+	"crit":     LevelFatal,
+	"critical": LevelFatal,
+	"alert":    LevelFatal,
+	"emerg":    LevelFatal,
+}
+
+// numericLevels are the levels logged as numbers, e.g. by pino and bunyan.
+var numericLevels = map[string]string{
+	"10": LevelTrace,
+	"20": LevelDebug,
+	"30": LevelInfo,
+	"40": LevelWarn,
+	"50": LevelError,
+	"60": LevelFatal,
+}
+
+// levelWords is the number of words at the start of an unstructured line
+// which are searched for its level, e.g. "2024-01-02T03:04:05Z [ERROR] ...".
+const levelWords = 6
+
+// NormaliseLevel returns the normalised level of a level name, e.g. "warn"
+// for "WARNING", or an empty string if it does not name a level.
+func NormaliseLevel(name string) string {
+	name = strings.ToLower(name)
+
+	if level, ok := levelNames[name]; ok {
+		return level
+	}
+
+	return numericLevels[name]
+}
+
+// DetectLevel returns the normalised level of a log line, or an empty string
+// if it has none.
+//
+// The level of a structured record is its level field. Otherwise, it is the
+// first of the first few words of the line which names a level, such as
+// "ERROR" in "2024-01-02 03:04:05 ERROR failed" or "level=error".
+func DetectLevel(line []byte) string {
+	if record, ok := Parse(line); ok {
+		if record.Level == nil {
+			return ""
+		}
+
+		return NormaliseLevel(record.Level.Text)
+	}
+
+	words := bytes.FieldsFunc(line, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	for i, word := range words {
+		if i >= levelWords {
+			break
+		}
+
+		if level, ok := levelNames[strings.ToLower(string(word))]; ok {
+			return level
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,187 @@
+// Package structured parses structured log lines, which are JSON objects, so
+// that they can be rendered field by field and filtered by the values of their
+// fields.
+package structured
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strconv"
+)
+
+// Kind is the kind of a field's value.
+type Kind string
+
+// The kinds of values, as in JSON.
+const (
+	String Kind = "string"
+	Number Kind = "number"
+	Bool   Kind = "bool"
+	Null   Kind = "null"
+	Object Kind = "object"
+	Array  Kind = "array"
+)
+
+// Keys under which the time, level and message of a record are commonly
+// logged, in order of preference.
+var (
+	TimeKeys    = []string{"time", "ts", "timestamp", "@timestamp", "t"}
+	LevelKeys   = []string{"level", "lvl", "severity", "log.level", "@level"}
+	MessageKeys = []string{"msg", "message", "@message", "log"}
+)
+
+// Field is a field of a record, which is either a scalar or an object or array
+// of further fields.
+type Field struct {
+	// Key is the key of the field within its parent, or the index of an
+	// element of an array.
+	Key string
+
+	// Path is the dotted path of the field from the record, e.g.
+	// "http.status", which filters refer to the field by.
+	Path string
+
+	Kind Kind
+
+	// Text is the value of a scalar field, as it is matched by filters, and
+	// Children are the fields of an object or the elements of an array.
+	Text     string
+	Children []Field
+}
+
+// Filter returns the filter matching records with the same value of the
+// field.
+func (f Field) Filter() Filter {
+	return Filter{
+		Path:  f.Path,
+		Value: f.Text,
+	}
+}
+
+// Record is a parsed structured log line.
+type Record struct {
+	// Time, Level and Message are the fields commonly present in records, if
+	// found.
+	Time    *Field
+	Level   *Field
+	Message *Field
+
+	// Fields are the remaining fields of the record, sorted by key.
+	Fields []Field
+}
+
+// Parse parses a log line as a structured record, reporting whether it is one.
+func Parse(line []byte) (Record, bool) {
+	values, ok := decode(line)
+	if !ok {
+		return Record{}, false
+	}
+
+	var record Record
+
+	// The well-known fields are only taken from the top level, or keys such as
+	// "log.level" written literally.
+	take := func(keys []string) *Field {
+		for _, key := range keys {
+			value, ok := values[key]
+			if !ok {
+				continue
+			}
+
+			field := newField(key, key, value)
+			if field.Kind == Object || field.Kind == Array {
+				continue
+			}
+
+			delete(values, key)
+
+			return &field
+		}
+
+		return nil
+	}
+
+	record.Time = take(TimeKeys)
+	record.Level = take(LevelKeys)
+	record.Message = take(MessageKeys)
+	record.Fields = objectFields("", values)
+
+	return record, true
+}
+
+// decode decodes a log line as a JSON object, keeping numbers as written.
+func decode(line []byte) (map[string]any, bool) {
+	line = bytes.TrimSpace(line)
+
+	if len(line) == 0 || line[0] != '{' {
+		return nil, false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.UseNumber()
+
+	var values map[string]any
+
+	if err := decoder.Decode(&values); err != nil {
+		return nil, false
+	}
+
+	return values, true
+}
+
+// newField creates the field holding the value at the given path.
+func newField(key string, path string, value any) Field {
+	field := Field{
+		Key:  key,
+		Path: path,
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		field.Kind = Object
+		field.Children = objectFields(path, value)
+	case []any:
+		field.Kind = Array
+		field.Children = make([]Field, len(value))
+
+		for i, element := range value {
+			index := strconv.Itoa(i)
+
+			field.Children[i] = newField(index, join(path, index), element)
+		}
+	default:
+		field.Kind = kindOf(value)
+		field.Text = format(value)
+	}
+
+	return field
+}
+
+// objectFields returns the fields of an object, sorted by key.
+func objectFields(path string, values map[string]any) []Field {
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	fields := make([]Field, len(keys))
+
+	for i, key := range keys {
+		fields[i] = newField(key, join(path, key), values[key])
+	}
+
+	return fields
+}
+
+// join joins a key onto the path of its parent.
+func join(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,123 @@
+package structured_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/crystalix007/log-viewer/structured"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want structured.Record
+	}{
+		{
+			name: "well-known fields",
+			line: `{"time":"2024-01-02T03:04:05Z","level":"info","msg":"ok","user":"bob"}`,
+			want: structured.Record{
+				Time:    &structured.Field{Key: "time", Path: "time", Kind: structured.String, Text: "2024-01-02T03:04:05Z"},
+				Level:   &structured.Field{Key: "level", Path: "level", Kind: structured.String, Text: "info"},
+				Message: &structured.Field{Key: "msg", Path: "msg", Kind: structured.String, Text: "ok"},
+				Fields: []structured.Field{
+					{Key: "user", Path: "user", Kind: structured.String, Text: "bob"},
+				},
+			},
+		},
+		{
+			name: "nested fields",
+			line: ` {"http":{"status":500,"headers":["a",{"b":true}]},"err":null} `,
+			want: structured.Record{
+				Fields: []structured.Field{
+					{Key: "err", Path: "err", Kind: structured.Null, Text: "null"},
+					{Key: "http", Path: "http", Kind: structured.Object, Children: []structured.Field{
+						{Key: "headers", Path: "http.headers", Kind: structured.Array, Children: []structured.Field{
+							{Key: "0", Path: "http.headers.0", Kind: structured.String, Text: "a"},
+							{Key: "1", Path: "http.headers.1", Kind: structured.Object, Children: []structured.Field{
+								{Key: "b", Path: "http.headers.1.b", Kind: structured.Bool, Text: "true"},
+							}},
+						}},
+						{Key: "status", Path: "http.status", Kind: structured.Number, Text: "500"},
+					}},
+				},
+			},
+		},
+		{
+			name: "numbers as written",
+			line: `{"big":12345678901234567890,"ratio":1.50}`,
+			want: structured.Record{
+				Fields: []structured.Field{
+					{Key: "big", Path: "big", Kind: structured.Number, Text: "12345678901234567890"},
+					{Key: "ratio", Path: "ratio", Kind: structured.Number, Text: "1.50"},
+				},
+			},
+		},
+		{
+			name: "alternative keys",
+			line: `{"ts":1704164645,"severity":"WARN","message":"slow","msg":{"id":1}}`,
+			want: structured.Record{
+				Time:    &structured.Field{Key: "ts", Path: "ts", Kind: structured.Number, Text: "1704164645"},
+				Level:   &structured.Field{Key: "severity", Path: "severity", Kind: structured.String, Text: "WARN"},
+				Message: &structured.Field{Key: "message", Path: "message", Kind: structured.String, Text: "slow"},
+				Fields: []structured.Field{
+					{Key: "msg", Path: "msg", Kind: structured.Object, Children: []structured.Field{
+						{Key: "id", Path: "msg.id", Kind: structured.Number, Text: "1"},
+					}},
+				},
+			},
+		},
+		{
+			name: "dotted well-known key",
+			line: `{"log.level":"error","log":{"file":"main.go"}}`,
+			want: structured.Record{
+				Level: &structured.Field{Key: "log.level", Path: "log.level", Kind: structured.String, Text: "error"},
+				Fields: []structured.Field{
+					{Key: "log", Path: "log", Kind: structured.Object, Children: []structured.Field{
+						{Key: "file", Path: "log.file", Kind: structured.String, Text: "main.go"},
+					}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			record, ok := structured.Parse([]byte(test.line))
+			if !ok {
+				t.Fatalf("Parse(%s) failed", test.line)
+			}
+
+			if !reflect.DeepEqual(record, test.want) {
+				t.Errorf("Parse(%s) = %+v, want %+v", test.line, record, test.want)
+			}
+		})
+	}
+}
+
+func TestParseUnstructured(t *testing.T) {
+	for _, line := range []string{
+		"",
+		"plain text",
+		"level=info msg=ok",
+		`["a","b"]`,
+		`{"unterminated":`,
+		`"{}"`,
+	} {
+		if record, ok := structured.Parse([]byte(line)); ok {
+			t.Errorf("Parse(%q) = %+v, want it unstructured", line, record)
+		}
+	}
+}
+
+func TestFieldFilter(t *testing.T) {
+	record, ok := structured.Parse([]byte(`{"http":{"status":500}}`))
+	if !ok {
+		t.Fatal("Parse failed")
+	}
+
+	filter := record.Fields[0].Children[0].Filter()
+	if want := (structured.Filter{Path: "http.status", Value: "500"}); filter != want {
+		t.Errorf("Filter() = %+v, want %+v", filter, want)
+	}
+}